@@ -2,14 +2,17 @@ package onepacd
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/app/onepacd/service/chainextract"
 	"github.com/1pactus/1pactus-react/app/onepacd/service/chainscan"
 	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi"
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/handler"
 	"github.com/1pactus/1pactus-react/app/onepacd/store"
 	"github.com/1pactus/1pactus-react/lifecycle"
 	"github.com/1pactus/1pactus-react/log"
@@ -20,27 +23,77 @@ const (
 	Version = "1.0.0.0"
 )
 
+// the extract and scan pipeline of a network
+type networkServices struct {
+	name         string
+	chainExtract *chainextract.ChainExtractService
+	chainscan    *chainscan.ChainscanService
+}
+
 var (
-	chainExtractService *chainextract.ChainExtractService
-	chainscanService    *chainscan.ChainscanService
-	webApiService       *webapi.WebApiService
+	networks      []*networkServices
+	webApiService *webapi.WebApiService
 )
 
-func InitServices(appLifeCycle *lifecycle.AppLifeCycle) error {
-	chainExtractService = chainextract.NewChainExtractService(appLifeCycle, conf.Service.ChainExtract, conf.Kafka.Enable)
-	chainscanService = chainscan.NewChainscanService(appLifeCycle, conf.Service.Chainscan, chainExtractService)
-	webApiService = webapi.NewWebApiService(appLifeCycle, conf.App.RunMode, conf.Service.WebApi)
+func InitNetworks() ([]*NetworkConfig, error) {
+	networkConfigs, err := conf.GetNetworks()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, network := range networkConfigs {
+		rules, err := constants.GetSupplyRules(network.SupplyRules)
+		if err != nil {
+			return nil, fmt.Errorf("network %q: %w", network.Name, err)
+		}
+
+		if _, err := store.InitNetwork(conf.ConfigBase, &store.NetworkConfig{
+			Name:           network.Name,
+			PostgresSchema: network.PostgresSchema,
+			MongoDatabase:  network.MongoDatabase,
+			KafkaTopic:     network.KafkaTopic,
+			SupplyRules:    rules,
+		}); err != nil {
+			return nil, fmt.Errorf("network %q: %w", network.Name, err)
+		}
+	}
+
+	return networkConfigs, nil
+}
 
-	appLifeCycle.WatchServiceLifeCycle(chainExtractService.ServiceLifeCycle)
-	appLifeCycle.WatchServiceLifeCycle(chainscanService.ServiceLifeCycle)
+func InitServices(appLifeCycle *lifecycle.AppLifeCycle, networkConfigs []*NetworkConfig) error {
+	scanTriggers := make(map[string]handler.ScanTrigger)
+
+	for _, network := range networkConfigs {
+		ns := store.GetNetwork(network.Name)
+
+		chainExtractService := chainextract.NewChainExtractService(appLifeCycle, network.Name,
+			&chainextract.Config{GrpcServers: network.GrpcServers}, ns.Kafka)
+		chainscanService := chainscan.NewChainscanService(appLifeCycle, network.Name, network.Chainscan,
+			chainExtractService, ns.Store, ns.SupplyRules)
+
+		appLifeCycle.WatchServiceLifeCycle(chainExtractService.ServiceLifeCycle)
+		appLifeCycle.WatchServiceLifeCycle(chainscanService.ServiceLifeCycle)
+
+		networks = append(networks, &networkServices{
+			name:         network.Name,
+			chainExtract: chainExtractService,
+			chainscan:    chainscanService,
+		})
+		scanTriggers[network.Name] = chainscanService
+	}
+
+	webApiService = webapi.NewWebApiService(appLifeCycle, conf.App.RunMode, conf.Service.WebApi, scanTriggers)
 	appLifeCycle.WatchServiceLifeCycle(webApiService.ServiceLifeCycle)
 
 	return nil
 }
 
 func RunServices() {
-	go chainExtractService.Run()
-	go chainscanService.Run()
+	for _, network := range networks {
+		go network.chainExtract.Run()
+		go network.chainscan.Run()
+	}
 	go webApiService.Run()
 }
 
@@ -49,13 +102,14 @@ func Run() {
 
 	defer log.Info("Stoped application")
 
-	if err := store.Init(conf.ConfigBase); err != nil {
+	networkConfigs, err := InitNetworks()
+	if err != nil {
 		log.Fatalf("failed to initialize store: %v", err)
 	}
 
 	appLifeCycle := lifecycle.NewAppLifeCycle()
 
-	if err := InitServices(appLifeCycle); err != nil {
+	if err := InitServices(appLifeCycle, networkConfigs); err != nil {
 		log.Fatalf("failed to initialize services: %v", err)
 	}
 
@@ -67,6 +121,10 @@ func Run() {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGHUP)
 
+	// SIGUSR1 starts a catch-up scan without restarting the daemon
+	scanChan := make(chan os.Signal, 1)
+	signal.Notify(scanChan, syscall.SIGUSR1)
+
 	go func() {
 		shutdownInitiated := false
 		timeoutTimer := time.NewTimer(0)
@@ -74,6 +132,10 @@ func Run() {
 
 		for {
 			select {
+			case <-scanChan:
+				for _, network := range networks {
+					log.Infof("received SIGUSR1, network=%s scan queued=%v", network.name, network.chainscan.TriggerScan())
+				}
 			case sig := <-sigChan:
 				if shutdownInitiated {
 					log.Infof("received signal %v during shutdown, ignoring", sig)
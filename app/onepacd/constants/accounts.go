@@ -32,3 +32,7 @@ func init() {
 
 	log.Infof("Loaded %d genesis accounts", len(accountsGenesis))
 }
+
+func GetAccountsGenesis() []*AccountsGenesis {
+	return accountsGenesis
+}
@@ -0,0 +1,10 @@
+package constants
+
+const (
+	// BlockInterval is the target time between two blocks, in seconds
+	BlockInterval = 10
+
+	// UnbondInterval is the number of blocks an unbonded stake stays locked
+	// before it can be withdrawn (21 days)
+	UnbondInterval = 181440
+)
@@ -0,0 +1,105 @@
+package constants
+
+import "fmt"
+
+const (
+	SupplyRulesMainnet = "mainnet"
+	SupplyRulesNone    = "none"
+
+	AccountLabelTreasury = "treasury"
+	AccountLabelReserve  = "reserve"
+	AccountLabelTeamHot  = "team_hot"
+)
+
+// SupplyRules tells which accounts of a network hold supply that is not in
+// circulation, and which accounts exist at genesis
+type SupplyRules interface {
+	IsReserveAccount(account string) bool
+	IsTeamHotAccount(account string) bool
+	GenesisAccounts() []*AccountsGenesis
+	// LabelledAccounts maps the treasury, reserve and team accounts to their
+	// label
+	LabelledAccounts() map[string]string
+	// UnbondInterval is the number of blocks an unbonded stake stays locked
+	UnbondInterval() int64
+	// BlockInterval is the target time between two blocks, in seconds
+	BlockInterval() int64
+}
+
+func GetSupplyRules(name string) (SupplyRules, error) {
+	switch name {
+	case SupplyRulesMainnet:
+		return mainnetSupplyRules{}, nil
+	case SupplyRulesNone:
+		return noSupplyRules{}, nil
+	default:
+		return nil, fmt.Errorf("unknown supply rules: %q", name)
+	}
+}
+
+type mainnetSupplyRules struct{}
+
+func (mainnetSupplyRules) IsReserveAccount(account string) bool {
+	return IsMainnetReserveAccount(account)
+}
+
+func (mainnetSupplyRules) IsTeamHotAccount(account string) bool {
+	return IsMainnetTeamHotAccount(account)
+}
+
+func (mainnetSupplyRules) GenesisAccounts() []*AccountsGenesis {
+	return GetAccountsGenesis()
+}
+
+func (mainnetSupplyRules) LabelledAccounts() map[string]string {
+	labels := make(map[string]string)
+
+	for _, account := range mainnetReserveAccounts {
+		labels[account] = AccountLabelReserve
+	}
+
+	for _, account := range mainnetTeamHotAccounts {
+		labels[account] = AccountLabelTeamHot
+	}
+
+	labels[Treasury] = AccountLabelTreasury
+
+	return labels
+}
+
+func (mainnetSupplyRules) UnbondInterval() int64 {
+	return UnbondInterval
+}
+
+func (mainnetSupplyRules) BlockInterval() int64 {
+	return BlockInterval
+}
+
+// noSupplyRules treats the whole supply as circulating, for networks such as
+// testnet or localnet
+type noSupplyRules struct{}
+
+func (noSupplyRules) IsReserveAccount(account string) bool {
+	return false
+}
+
+func (noSupplyRules) IsTeamHotAccount(account string) bool {
+	return false
+}
+
+func (noSupplyRules) GenesisAccounts() []*AccountsGenesis {
+	return nil
+}
+
+func (noSupplyRules) LabelledAccounts() map[string]string {
+	return map[string]string{Treasury: AccountLabelTreasury}
+}
+
+// networks without their own rules run the default consensus parameters
+func (noSupplyRules) UnbondInterval() int64 {
+	return UnbondInterval
+}
+
+func (noSupplyRules) BlockInterval() int64 {
+	return BlockInterval
+}
@@ -0,0 +1,59 @@
+package onepacd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
+)
+
+// ImportMongo copies the mongo collections of the named network, or of every
+// configured network when network is empty, into its postgres schema and
+// validates the copy. validateOnly only compares the two.
+func ImportMongo(network string, batchSize int, validateOnly bool) error {
+	return forEachNetwork(network, func(n *store.NetworkConfig) error {
+		return store.ImportMongo(conf.ConfigBase, n, batchSize, func(importer *store.MongoImporter) error {
+			return runImportMongo(importer, n.Name, validateOnly)
+		})
+	})
+}
+
+func runImportMongo(importer *store.MongoImporter, network string, validateOnly bool) error {
+	if !validateOnly {
+		results, err := importer.Import()
+
+		for _, r := range results {
+			fmt.Printf("%s: %s %d rows imported in %v\n", network, r.Name, r.Rows, r.Duration.Round(time.Millisecond))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	checks, err := importer.Validate()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+
+	fmt.Printf("%s: %-22s %12s %12s %24s %24s\n", network, "collection", "mongo rows", "pg rows", "mongo total", "pg total")
+
+	for _, c := range checks {
+		status := "ok"
+		if !c.Passed() {
+			status = "MISMATCH"
+			failed++
+		}
+
+		fmt.Printf("%s: %-22s %12d %12d %24d %24d %s\n", network, c.Name, c.MongoRows, c.PgRows, c.MongoTotal, c.PgTotal, status)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+
+	fmt.Printf("%s: %d checks passed\n", network, len(checks))
+	return nil
+}
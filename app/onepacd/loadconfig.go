@@ -1,13 +1,31 @@
 package onepacd
 
 import (
+	"fmt"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/app/onepacd/service"
+	"github.com/1pactus/1pactus-react/app/onepacd/service/chainscan"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
 	"github.com/1pactus/1pactus-react/config"
 )
 
 type Config struct {
 	*config.ConfigBase `mapstructure:",squash"`
-	Service            *service.Config `mapstructure:"service"`
+	Service            *service.Config  `mapstructure:"service"`
+	Networks           []*NetworkConfig `mapstructure:"networks"`
+}
+
+// NetworkConfig defines a network indexed by its own extract and scan
+// pipeline. Empty fields fall back to the service and store settings.
+type NetworkConfig struct {
+	Name           string            `mapstructure:"name"`
+	GrpcServers    []string          `mapstructure:"grpc_servers"`
+	KafkaTopic     string            `mapstructure:"kafka_topic"`
+	PostgresSchema string            `mapstructure:"postgres_schema"`
+	MongoDatabase  string            `mapstructure:"mongo_database"`
+	SupplyRules    string            `mapstructure:"supply_rules"`
+	Chainscan      *chainscan.Config `mapstructure:"chainscan"`
 }
 
 var conf = Config{
@@ -19,3 +37,64 @@ func LoadConfig(app string, files []string, cliOverrides []string) (err error) {
 	err = config.LoadConfig(app, files, cliOverrides, &conf)
 	return
 }
+
+// GetNetworks returns the configured networks with defaults applied, or a
+// single mainnet network built from the service settings when none is
+// configured.
+func (c *Config) GetNetworks() ([]*NetworkConfig, error) {
+	networks := c.Networks
+
+	if len(networks) == 0 {
+		networks = []*NetworkConfig{{Name: constants.SupplyRulesMainnet}}
+	}
+
+	names := make(map[string]bool)
+	topics := make(map[string]bool)
+	schemas := make(map[string]bool)
+	mongoDatabases := make(map[string]bool)
+
+	for _, network := range networks {
+		if network.Name == "" {
+			return nil, fmt.Errorf("network name is required")
+		}
+
+		if len(network.GrpcServers) == 0 {
+			network.GrpcServers = c.Service.ChainExtract.GrpcServers
+		}
+
+		if network.KafkaTopic == "" {
+			network.KafkaTopic = store.KafkaDefaultTopicBlocks
+		}
+
+		if network.SupplyRules == "" {
+			network.SupplyRules = constants.SupplyRulesMainnet
+		}
+
+		if network.Chainscan == nil {
+			network.Chainscan = c.Service.Chainscan
+		}
+
+		if names[network.Name] {
+			return nil, fmt.Errorf("network %q is defined twice", network.Name)
+		}
+
+		if topics[network.KafkaTopic] {
+			return nil, fmt.Errorf("network %q shares kafka topic %q with another network", network.Name, network.KafkaTopic)
+		}
+
+		if schemas[network.PostgresSchema] {
+			return nil, fmt.Errorf("network %q shares postgres schema %q with another network", network.Name, network.PostgresSchema)
+		}
+
+		if c.Store.Backend == store.BackendMongo && mongoDatabases[network.MongoDatabase] {
+			return nil, fmt.Errorf("network %q shares mongo database %q with another network", network.Name, network.MongoDatabase)
+		}
+
+		names[network.Name] = true
+		topics[network.KafkaTopic] = true
+		schemas[network.PostgresSchema] = true
+		mongoDatabases[network.MongoDatabase] = true
+	}
+
+	return networks, nil
+}
@@ -0,0 +1,106 @@
+package onepacd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+)
+
+const (
+	MigrateUp     = "up"
+	MigrateDown   = "down"
+	MigrateStatus = "status"
+)
+
+// Migrate runs a migration action against the postgres schema of the named
+// network, or of every configured network when network is empty. steps limits
+// how many migrations down reverts.
+func Migrate(action string, network string, steps int) error {
+	return forEachNetwork(network, func(n *store.NetworkConfig) error {
+		return store.Migrate(conf.ConfigBase, n, func(migrator *storedriver.PostgresMigrator) error {
+			return runMigrate(migrator, n.Name, action, steps)
+		})
+	})
+}
+
+// forEachNetwork runs fn for the named network, or for every configured
+// network when network is empty
+func forEachNetwork(network string, fn func(n *store.NetworkConfig) error) error {
+	networkConfigs, err := conf.GetNetworks()
+	if err != nil {
+		return err
+	}
+
+	found := false
+
+	for _, n := range networkConfigs {
+		if network != "" && n.Name != network {
+			continue
+		}
+		found = true
+
+		rules, err := constants.GetSupplyRules(n.SupplyRules)
+		if err != nil {
+			return fmt.Errorf("network %q: %w", n.Name, err)
+		}
+
+		err = fn(&store.NetworkConfig{
+			Name:           n.Name,
+			PostgresSchema: n.PostgresSchema,
+			MongoDatabase:  n.MongoDatabase,
+			KafkaTopic:     n.KafkaTopic,
+			SupplyRules:    rules,
+		})
+
+		if err != nil {
+			return fmt.Errorf("network %q: %w", n.Name, err)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("unknown network %q", network)
+	}
+
+	return nil
+}
+
+func runMigrate(migrator *storedriver.PostgresMigrator, network string, action string, steps int) error {
+	switch action {
+	case MigrateUp:
+		count, err := migrator.Up()
+		fmt.Printf("%s: %d migrations applied\n", network, count)
+		return err
+	case MigrateDown:
+		count, err := migrator.Down(steps)
+		fmt.Printf("%s: %d migrations reverted\n", network, count)
+		return err
+	case MigrateStatus:
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+
+		version, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: schema version %d, latest %d\n", network, version, migrator.Latest())
+
+		for _, s := range statuses {
+			applied := "pending"
+			if s.Applied {
+				applied = "applied " + time.Unix(s.AppliedAt, 0).UTC().Format(time.RFC3339)
+			}
+
+			fmt.Printf("  %04d_%s %s\n", s.Version, s.Name, applied)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate action %q", action)
+	}
+}
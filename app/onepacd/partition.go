@@ -0,0 +1,81 @@
+package onepacd
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+)
+
+const (
+	PartitionList   = "list"
+	PartitionEnsure = "ensure"
+	PartitionDetach = "detach"
+
+	// month layout of the detach --before flag
+	PartitionMonthLayout = "2006-01"
+)
+
+// Partitions runs a partition action against the monthly partitioned tables
+// of the named network, or of every configured network when network is empty.
+// detach detaches the partitions ending at or before the month before, moving
+// them to archiveSchema unless it is empty.
+func Partitions(action string, network string, before string, archiveSchema string) error {
+	var beforeUnix int64
+
+	if action == PartitionDetach {
+		month, err := time.Parse(PartitionMonthLayout, before)
+		if err != nil {
+			return fmt.Errorf("invalid month %q, expected YYYY-MM", before)
+		}
+		beforeUnix = month.Unix()
+	}
+
+	return forEachNetwork(network, func(n *store.NetworkConfig) error {
+		return store.Partitions(conf.ConfigBase, n, func(partitioner *storedriver.PostgresPartitioner) error {
+			return runPartitions(partitioner, n.Name, action, beforeUnix, archiveSchema)
+		})
+	})
+}
+
+func formatPartitionBound(bound int64) string {
+	switch bound {
+	case math.MinInt64:
+		return "MINVALUE"
+	case math.MaxInt64:
+		return "MAXVALUE"
+	}
+
+	return time.Unix(bound, 0).UTC().Format(time.DateOnly)
+}
+
+func runPartitions(partitioner *storedriver.PostgresPartitioner, network string, action string, before int64, archiveSchema string) error {
+	switch action {
+	case PartitionList:
+		partitions, err := partitioner.List()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: %d partitions\n", network, len(partitions))
+
+		for _, p := range partitions {
+			fmt.Printf("  %s [%s, %s) ~%d rows\n", p.Name, formatPartitionBound(p.From), formatPartitionBound(p.To), p.Rows)
+		}
+
+		return nil
+	case PartitionEnsure:
+		return partitioner.Ensure(time.Now().Unix())
+	case PartitionDetach:
+		detached, err := partitioner.Detach(before, archiveSchema)
+		for _, p := range detached {
+			fmt.Printf("%s: %s detached\n", network, p.Name)
+		}
+		fmt.Printf("%s: %d partitions detached\n", network, len(detached))
+		return err
+	default:
+		return fmt.Errorf("unknown partition action %q", action)
+	}
+}
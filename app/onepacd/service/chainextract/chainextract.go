@@ -6,26 +6,27 @@ import (
 
 	"github.com/1pactus/1pactus-react/app/onepacd/service/chainextract/chainreader"
 	gather "github.com/1pactus/1pactus-react/app/onepacd/service/chainextract/chainreader"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
 	"github.com/1pactus/1pactus-react/lifecycle"
 	"github.com/1pactus/1pactus-react/log"
 )
 
 type ChainExtractService struct {
 	*lifecycle.ServiceLifeCycle
-	log         log.ILogger
-	config      *Config
-	grpc        *gather.GrpcClient
-	kafkaEnable bool
-	mainReader  atomic.Value // stores chainreader.BlockchainReader
+	log        log.ILogger
+	config     *Config
+	grpc       *gather.GrpcClient
+	kafka      store.IKafka // nil when kafka is disabled
+	mainReader atomic.Value // stores chainreader.BlockchainReader
 }
 
-func NewChainExtractService(appLifeCycle *lifecycle.AppLifeCycle, config *Config, kafkaEnable bool) *ChainExtractService {
+func NewChainExtractService(appLifeCycle *lifecycle.AppLifeCycle, network string, config *Config, kafka store.IKafka) *ChainExtractService {
 	return &ChainExtractService{
 		ServiceLifeCycle: lifecycle.NewServiceLifeCycle(appLifeCycle),
-		log:              log.WithKv("service", "chainextract"),
+		log:              log.WithKv("service", "chainextract").WithKv("network", network),
 		config:           config,
 		grpc:             gather.NewGrpcClient(time.Second*5, config.GrpcServers),
-		kafkaEnable:      kafkaEnable,
+		kafka:            kafka,
 	}
 }
 
@@ -51,11 +52,11 @@ func (s *ChainExtractService) Run() {
 		return
 	}
 
-	s.log.Infof("kafka enable: %v", s.kafkaEnable)
+	s.log.Infof("kafka enable: %v", s.kafka != nil)
 
 	var reader chainreader.BlockchainReader
-	if s.kafkaEnable {
-		reader, err = chainreader.NewBlockchainKafkaReader(s.ServiceLifeCycle.Context(), s.grpc, s.log)
+	if s.kafka != nil {
+		reader, err = chainreader.NewBlockchainKafkaReader(s.ServiceLifeCycle.Context(), s.grpc, s.kafka, s.log)
 	} else {
 		reader, err = chainreader.NewBlockchainGrpcReader(s.ServiceLifeCycle.Context(), s.grpc, s.log)
 	}
@@ -69,4 +70,4 @@ func (s *ChainExtractService) Run() {
 	defer reader.Close()
 
 	<-s.Done()
-}
\ No newline at end of file
+}
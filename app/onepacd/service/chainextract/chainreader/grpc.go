@@ -146,6 +146,20 @@ func (c *GrpcClient) getValidator(addrStr string) (*pactus.ValidatorInfo, error)
 	return res.Validator, nil
 }
 
+func (c *GrpcClient) GetValidatorByNumber(number int32) (*pactus.ValidatorInfo, error) {
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+
+	res, err := c.blockchainClient.GetValidatorByNumber(c.ctx,
+		&pactus.GetValidatorByNumberRequest{Number: number})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Validator, nil
+}
+
 func (c *GrpcClient) sendTx(trx *tx.Tx) (tx.ID, error) {
 	if err := c.Connect(); err != nil {
 		return hash.UndefHash, err
@@ -11,6 +11,7 @@ import (
 
 type blockchainGrpcReaderImpl struct {
 	consumerSyncMap sync.Map
+	validatorCache  sync.Map // validator number -> address
 	grpc            *GrpcClient
 	log             log.ILogger
 	ctx             context.Context
@@ -65,6 +66,30 @@ func (r *blockchainGrpcReaderImpl) GetBlockchainInfo() (*pactus.GetBlockchainInf
 	return r.grpc.GetBlockchainInfo()
 }
 
+func (r *blockchainGrpcReaderImpl) GetValidatorAddress(number int32) (string, error) {
+	if address, ok := r.validatorCache.Load(number); ok {
+		return address.(string), nil
+	}
+
+	validator, err := r.grpc.GetValidatorByNumber(number)
+	if err != nil {
+		return "", err
+	}
+
+	// validator numbers are never reassigned
+	r.validatorCache.Store(number, validator.Address)
+
+	return validator.Address, nil
+}
+
+func (r *blockchainGrpcReaderImpl) GetAccount(address string) (*pactus.AccountInfo, error) {
+	return r.grpc.getAccount(address)
+}
+
+func (r *blockchainGrpcReaderImpl) GetValidator(address string) (*pactus.ValidatorInfo, error) {
+	return r.grpc.getValidator(address)
+}
+
 func (r *blockchainGrpcReaderImpl) CreateGroup(beginHeight int64, consumerGroupID string) (BlockchainReaderGroup, bool) {
 	consumer, exists := r.consumerSyncMap.LoadOrStore(consumerGroupID, &blockchainGrpcReaderGroupImpl{
 		reader:    r,
@@ -9,6 +9,11 @@ type BlockchainReader interface {
 	Close()
 
 	GetBlockchainInfo() (*pactus.GetBlockchainInfoResponse, error)
+	// GetValidatorAddress resolves a validator number, as used in block
+	// certificates, to the validator address
+	GetValidatorAddress(number int32) (string, error)
+	GetAccount(address string) (*pactus.AccountInfo, error)
+	GetValidator(address string) (*pactus.ValidatorInfo, error)
 }
 
 type BlockchainReaderGroup interface {
@@ -12,6 +12,7 @@ import (
 )
 
 type blockchainKafkaReaderImpl struct {
+	kafka               store.IKafka
 	grpcReader          BlockchainReader
 	log                 log.ILogger
 	ctx                 context.Context
@@ -37,9 +38,10 @@ type blockchainKafkaReaderConsumer struct {
 	closeOnce   sync.Once
 }
 
-func NewBlockchainKafkaReader(parentCtx context.Context, grpc *GrpcClient, parentLogger log.ILogger) (BlockchainReader, error) {
+func NewBlockchainKafkaReader(parentCtx context.Context, grpc *GrpcClient, kafka store.IKafka, parentLogger log.ILogger) (BlockchainReader, error) {
 	reader := &blockchainKafkaReaderImpl{
-		log: parentLogger.WithKv("reader", "kafka"),
+		kafka: kafka,
+		log:   parentLogger.WithKv("reader", "kafka"),
 	}
 
 	reader.ctx, reader.cancel = context.WithCancel(parentCtx)
@@ -52,7 +54,7 @@ func NewBlockchainKafkaReader(parentCtx context.Context, grpc *GrpcClient, paren
 }
 
 func (r *blockchainKafkaReaderImpl) initGrpcReader(grpc *GrpcClient) error {
-	height, err := store.Kafka.GetLastBlockHeight()
+	height, err := r.kafka.GetLastBlockHeight()
 	if err != nil {
 		if err == store.ErrorKafkaTopicEmpty {
 			r.log.Infof("kafka topic is empty, starting from block height %d", height)
@@ -82,6 +84,18 @@ func (r *blockchainKafkaReaderImpl) GetBlockchainInfo() (*pactus.GetBlockchainIn
 	return r.grpcReader.GetBlockchainInfo()
 }
 
+func (r *blockchainKafkaReaderImpl) GetValidatorAddress(number int32) (string, error) {
+	return r.grpcReader.GetValidatorAddress(number)
+}
+
+func (r *blockchainKafkaReaderImpl) GetAccount(address string) (*pactus.AccountInfo, error) {
+	return r.grpcReader.GetAccount(address)
+}
+
+func (r *blockchainKafkaReaderImpl) GetValidator(address string) (*pactus.ValidatorInfo, error) {
+	return r.grpcReader.GetValidator(address)
+}
+
 func (r *blockchainKafkaReaderImpl) CreateGroup(beginHeight int64, consumerGroupID string) (BlockchainReaderGroup, bool) {
 	consumer, exists := r.consumerSyncMap.LoadOrStore(consumerGroupID, &blockchainKafkaReaderConsumer{
 		reader:      r,
@@ -139,7 +153,7 @@ func (r *blockchainKafkaReaderImpl) runProducer() error {
 	defer group.Close()
 
 	for block := range group.Read() {
-		err := store.Kafka.SendBlock(block)
+		err := r.kafka.SendBlock(block)
 		if err != nil {
 			return err
 		}
@@ -188,7 +202,7 @@ func (r *blockchainKafkaReaderConsumer) safeRunConsumer() {
 }
 
 func (r *blockchainKafkaReaderConsumer) runConsumer() error {
-	topicOffset, err := store.Kafka.GetBlockHeightOffset(r.beginHeight)
+	topicOffset, err := r.reader.kafka.GetBlockHeightOffset(r.beginHeight)
 
 	if err != nil {
 		for {
@@ -202,7 +216,7 @@ func (r *blockchainKafkaReaderConsumer) runConsumer() error {
 				}
 			}
 
-			topicOffset, err = store.Kafka.GetBlockHeightOffset(r.beginHeight)
+			topicOffset, err = r.reader.kafka.GetBlockHeightOffset(r.beginHeight)
 
 			if err == nil {
 				r.log.Infof("GetBlockHeightOffset beginHeight=%v succeeded", r.beginHeight)
@@ -213,7 +227,7 @@ func (r *blockchainKafkaReaderConsumer) runConsumer() error {
 
 	defer close(r.blockChan)
 
-	err = store.Kafka.ConsumeBlocks(r.ctx, r.groupID, topicOffset, r.blockChan)
+	err = r.reader.kafka.ConsumeBlocks(r.ctx, r.groupID, topicOffset, r.blockChan)
 
 	if err != nil {
 		if err == context.Canceled {
@@ -1,10 +1,13 @@
 package chainscan
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/app/onepacd/service/chainextract/chainreader"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
 	"github.com/1pactus/1pactus-react/lifecycle"
 	"github.com/1pactus/1pactus-react/log"
 	"github.com/robfig/cron/v3"
@@ -21,15 +24,37 @@ type ChainscanService struct {
 	cron           *cron.Cron
 	reader         chainreader.BlockchainReader
 	readerProvider ReaderProvider
+	gatherChan     chan struct{}
+	postgres       store.IStore
+	rules          constants.SupplyRules
 }
 
-func NewChainscanService(appLifeCycle *lifecycle.AppLifeCycle, config *Config, readerProvider ReaderProvider) *ChainscanService {
+func NewChainscanService(appLifeCycle *lifecycle.AppLifeCycle, network string, config *Config, readerProvider ReaderProvider,
+	postgres store.IStore, rules constants.SupplyRules) *ChainscanService {
+	if config == nil {
+		config = NewDefaultConfig()
+	}
+
 	return &ChainscanService{
 		ServiceLifeCycle: lifecycle.NewServiceLifeCycle(appLifeCycle),
-		log:              log.WithKv("service", "chainscan"),
+		log:              log.WithKv("service", "chainscan").WithKv("network", network),
 		config:           config,
 		cron:             cron.New(cron.WithLocation(time.UTC)),
 		readerProvider:   readerProvider,
+		gatherChan:       make(chan struct{}, 2),
+		postgres:         postgres,
+		rules:            rules,
+	}
+}
+
+// TriggerScan queues a scan outside of the schedule. It returns false when a
+// scan is already queued.
+func (s *ChainscanService) TriggerScan() bool {
+	select {
+	case s.gatherChan <- struct{}{}:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -38,10 +63,6 @@ func (s *ChainscanService) Run() {
 	defer s.log.Info("Chain Scan Service stopped")
 	s.log.Infof("Chain Scan Service is starting...")
 
-	gatherChan := make(chan struct{}, 2)
-
-	defer close(gatherChan)
-
 	time.Sleep(1 * time.Second) // wait for chainextract to start
 
 	for {
@@ -61,9 +82,9 @@ func (s *ChainscanService) Run() {
 		}
 	}
 
-	_, err := s.cron.AddFunc("10 0 * * *", func() {
-		s.log.Info("starting scheduled task at UTC 00:10")
-		gatherChan <- struct{}{}
+	_, err := s.cron.AddFunc(s.config.Schedule, func() {
+		s.log.Infof("starting scheduled task, schedule=%q", s.config.Schedule)
+		s.TriggerScan()
 	})
 	if err != nil {
 		s.log.Errorf("failed to add cron job: %v", err)
@@ -73,26 +94,37 @@ func (s *ChainscanService) Run() {
 	s.cron.Start()
 	defer s.cron.Stop()
 
-	gatherChan <- struct{}{}
+	s.TriggerScan()
 
-	go s.runWorker(gatherChan)
+	go s.runWorker()
 
 	<-s.Done()
 	s.log.Info("data collect received done signal")
 }
 
-func (s *ChainscanService) runWorker(gatherChan <-chan struct{}) {
+func (s *ChainscanService) runWorker() {
 	s.log.Infof("gather waiting started")
 	defer s.log.Infof("gather waiting stopped")
-	for range gatherChan {
-		err := s.startScan(s.Done())
+	for {
+		select {
+		case <-s.Done():
+			return
+		case <-s.gatherChan:
+		}
+
+		pending, err := s.startScan(s.Done())
 		if err != nil {
 			s.log.Errorf("%v", err)
+			continue
+		}
+
+		if err := s.startAudit(pending); err != nil {
+			s.log.Errorf("%v", err)
 		}
 	}
 }
 
-func (s *ChainscanService) startScan(dieChan <-chan struct{}) (err error) {
+func (s *ChainscanService) startScan(dieChan <-chan struct{}) (pending *auditPending, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("startScan panic: %v", r)
@@ -107,10 +139,41 @@ func (s *ChainscanService) startScan(dieChan <-chan struct{}) (err error) {
 			timeStart.UTC(), time.Now().UTC(), time.Since(timeStart))
 	}()
 
-	cg := newScanWorker(s.log, s.reader)
+	cg := newScanWorker(s.log, s.reader, s.config, s.postgres, s.rules)
+
+	pending, err = cg.FetchBlockchain(dieChan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch blockchain: %w", err)
+	}
+
+	return pending, nil
+}
+
+// startAudit audits the store once a scan committed its days, pending holds
+// the changes of the blocks the scan read after them
+func (s *ChainscanService) startAudit(pending *auditPending) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("startAudit panic: %v", r)
+		}
+	}()
+
+	run, err := newAuditWorker(s.log, s.reader, s.postgres, s.rules).Audit(pending)
+	if errors.Is(err, store.ErrUnsupported) {
+		s.log.Debugf("audit skipped: %v", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to audit: %w", err)
+	}
 
-	if err := cg.FetchBlockchain(dieChan); err != nil {
-		return fmt.Errorf("failed to fetch blockchain: %w", err)
+	if run.Discrepancies > 0 {
+		s.log.Warnf("audit found %d discrepancies in %d checks, indexedHeight=%d nodeHeight=%d",
+			run.Discrepancies, run.Checks, run.IndexedHeight, run.NodeHeight)
+	} else {
+		s.log.Infof("audit passed %d checks, indexedHeight=%d nodeHeight=%d",
+			run.Checks, run.IndexedHeight, run.NodeHeight)
 	}
 
 	return nil
@@ -1,8 +1,22 @@
 package chainscan
 
 type Config struct {
+	// cron spec of the scheduled scans, evaluated in UTC
+	Schedule string `mapstructure:"schedule"`
+	// consumer group the scanner reads blocks with
+	ConsumerGroupID string `mapstructure:"consumer_group_id"`
+	// daily commits buffered between the scanner and postgres
+	CommitChanSize int `mapstructure:"commit_chan_size"`
+	// blocks produced more than this many seconds after their parent count as
+	// slow, zero disables the count
+	SlowBlockSeconds int64 `mapstructure:"slow_block_seconds"`
 }
 
 func NewDefaultConfig() *Config {
-	return &Config{}
+	return &Config{
+		Schedule:         "10 0 * * *",
+		ConsumerGroupID:  "pg_gatherer",
+		CommitChanSize:   64,
+		SlowBlockSeconds: 20,
+	}
 }
@@ -0,0 +1,192 @@
+package chainscan
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
+	"github.com/1pactus/1pactus-react/app/onepacd/service/chainextract/chainreader"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/log"
+)
+
+const (
+	auditSampleSize = 20
+)
+
+// auditPending holds the changes of the blocks scanned after the committed
+// days, up to height. The store is behind the node by them.
+type auditPending struct {
+	height     int64
+	balances   map[string]int64
+	stakes     map[string]int64
+	unbondings int64
+}
+
+func newAuditPending(height int64, txMerger *model.TxMerger) *auditPending {
+	pending := &auditPending{
+		height:   height,
+		balances: make(map[string]int64),
+		stakes:   make(map[string]int64),
+	}
+
+	for _, row := range txMerger.AccountBalances() {
+		pending.balances[row.Address] = row.Balance
+	}
+
+	for _, row := range txMerger.ValidatorStates() {
+		pending.stakes[row.Address] = row.Stake
+	}
+
+	for _, unbond := range txMerger.ValidatorUnbonds() {
+		pending.unbondings += unbond.Stake
+	}
+
+	return pending
+}
+
+// stakeTolerance bounds the change of the bonded stake, the pending unbonds
+// leave it
+func (p *auditPending) stakeTolerance() int64 {
+	tolerance := p.unbondings
+	for _, change := range p.stakes {
+		tolerance += max(change, -change)
+	}
+
+	return tolerance
+}
+
+// workerAudit compares the state derived by workerScan with what the node
+// reports. The store holds the committed days only, so the balances and
+// stakes of the addresses get the pending changes of the scan before they are
+// compared, and the totals, which the node reports for its top block only, are
+// allowed to differ by what the pending blocks changed. Blocks the node added
+// after the scan are not accounted for.
+type workerAudit struct {
+	log      log.ILogger
+	reader   chainreader.BlockchainReader
+	postgres store.IStore
+	rules    constants.SupplyRules
+}
+
+func newAuditWorker(log log.ILogger, reader chainreader.BlockchainReader, postgres store.IStore, rules constants.SupplyRules) *workerAudit {
+	return &workerAudit{
+		log:      log,
+		reader:   reader,
+		postgres: postgres,
+		rules:    rules,
+	}
+}
+
+func (p *workerAudit) Audit(pending *auditPending) (*model.AuditRun, error) {
+	totals, err := p.postgres.GetIndexedTotals()
+	if err != nil {
+		return nil, fmt.Errorf("getIndexedTotals failed: %w", err)
+	}
+
+	info, err := p.reader.GetBlockchainInfo()
+	if err != nil {
+		return nil, fmt.Errorf("getBlockchainInfo failed: %v", err)
+	}
+
+	run := &model.AuditRun{
+		Time:          time.Now().Unix(),
+		IndexedHeight: pending.height,
+		NodeHeight:    int64(info.LastBlockHeight),
+	}
+
+	// the totals move by the pending changes, new accounts and validators
+	// are among the pending ones
+	run.CheckWithin(model.AuditCheckStake, "", totals.BondedStake, info.TotalPower, pending.stakeTolerance())
+	run.CheckWithin(model.AuditCheckValidators, "", totals.Validators, int64(info.TotalValidators), int64(len(pending.stakes)))
+	run.CheckWithin(model.AuditCheckAccounts, "", totals.Accounts, int64(info.TotalAccounts), int64(len(pending.balances)))
+
+	if err := p.auditSupply(run, pending); err != nil {
+		return nil, err
+	}
+
+	accounts, err := p.postgres.GetRandomAccountBalances(auditSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("getRandomAccountBalances failed: %v", err)
+	}
+
+	for _, account := range accounts {
+		nodeAccount, err := p.reader.GetAccount(account.Address)
+		if err != nil {
+			p.log.Warnf("audit getAccount %v failed: %v", account.Address, err)
+			continue
+		}
+
+		run.Check(model.AuditCheckAccountBalance, account.Address, account.Balance+pending.balances[account.Address], nodeAccount.Balance)
+	}
+
+	validators, err := p.postgres.GetRandomValidatorStates(auditSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("getRandomValidatorStates failed: %v", err)
+	}
+
+	for _, validator := range validators {
+		nodeValidator, err := p.reader.GetValidator(validator.Address)
+		if err != nil {
+			p.log.Warnf("audit getValidator %v failed: %v", validator.Address, err)
+			continue
+		}
+
+		run.Check(model.AuditCheckValidatorStake, validator.Address, validator.Stake+pending.stakes[validator.Address], nodeValidator.Stake)
+	}
+
+	if err := p.postgres.InsertAuditRun(run); err != nil {
+		return nil, fmt.Errorf("insertAuditRun failed: %v", err)
+	}
+
+	return run, nil
+}
+
+// auditSupply compares the supply the reserve and team accounts released since
+// genesis, computed from their indexed balances and from their node balances
+func (p *workerAudit) auditSupply(run *model.AuditRun, pending *auditPending) error {
+	var addresses []string
+	for address := range p.rules.LabelledAccounts() {
+		if p.rules.IsReserveAccount(address) || p.rules.IsTeamHotAccount(address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	// every account is in circulation
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	genesis := make(map[string]int64)
+	for _, account := range p.rules.GenesisAccounts() {
+		genesis[account.Address] = account.Balance
+	}
+
+	balances, err := p.postgres.GetAccountBalances(addresses)
+	if err != nil {
+		return fmt.Errorf("getAccountBalances failed: %v", err)
+	}
+
+	indexed := make(map[string]int64, len(balances))
+	for _, balance := range balances {
+		indexed[balance.Address] = balance.Balance
+	}
+
+	var indexedSupply, nodeSupply int64
+
+	for _, address := range addresses {
+		nodeAccount, err := p.reader.GetAccount(address)
+		if err != nil {
+			p.log.Warnf("audit getAccount %v failed, supply not checked: %v", address, err)
+			return nil
+		}
+
+		indexedSupply += genesis[address] - indexed[address] - pending.balances[address]
+		nodeSupply += genesis[address] - nodeAccount.Balance
+	}
+
+	run.Check(model.AuditCheckSupply, "", indexedSupply, nodeSupply)
+
+	return nil
+}
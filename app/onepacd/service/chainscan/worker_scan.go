@@ -18,12 +18,18 @@ type workerScan struct {
 	log         log.ILogger
 	grpcServers []string
 	reader      chainreader.BlockchainReader
+	config      *Config
+	postgres    store.IStore
+	rules       constants.SupplyRules
 }
 
-func newScanWorker(log log.ILogger, reader chainreader.BlockchainReader) *workerScan {
+func newScanWorker(log log.ILogger, reader chainreader.BlockchainReader, config *Config, postgres store.IStore, rules constants.SupplyRules) *workerScan {
 	p := &workerScan{
-		log:    log,
-		reader: reader,
+		log:      log,
+		reader:   reader,
+		config:   config,
+		postgres: postgres,
+		rules:    rules,
 	}
 
 	return p
@@ -41,35 +47,102 @@ func (p *workerScan) getDailyStartHeight(height uint32) uint32 {
 }
 
 func (p *workerScan) GetTimeIndex(timestamp uint32) int64 {
-	t := time.Unix(int64(timestamp), 0).UTC()
+	return model.GetTimeIndex(int64(timestamp))
+}
+
+// resolveCertificate maps the committee numbers of a certificate to validator
+// addresses, split into the members that signed it and the absentees
+func (p *workerScan) resolveCertificate(cert *pactus.CertificateInfo) ([]string, []string, error) {
+	absent := make(map[int32]bool, len(cert.Absentees))
+	for _, number := range cert.Absentees {
+		absent[number] = true
+	}
+
+	signers := make([]string, 0, len(cert.Committers))
+	absentees := make([]string, 0, len(cert.Absentees))
+
+	for _, number := range cert.Committers {
+		address, err := p.reader.GetValidatorAddress(number)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getValidatorAddress %v failed: %w", number, err)
+		}
+
+		if absent[number] {
+			absentees = append(absentees, address)
+		} else {
+			signers = append(signers, address)
+		}
+	}
+
+	return signers, absentees, nil
+}
+
+// validatorStakes follows the stake of the validators a scan sees. A validator
+// is loaded from the store the first time it is seen, the pending commits hold
+// no change of it then.
+type validatorStakes struct {
+	store  store.IStore
+	stakes map[string]int64
+}
+
+func newValidatorStakes(store store.IStore) *validatorStakes {
+	return &validatorStakes{
+		store:  store,
+		stakes: make(map[string]int64),
+	}
+}
+
+func (v *validatorStakes) Get(address string) (int64, error) {
+	if stake, ok := v.stakes[address]; ok {
+		return stake, nil
+	}
 
-	year, month, day := t.Date()
+	stake, err := v.store.GetValidatorStake(address)
+	if err != nil {
+		return 0, err
+	}
 
-	dayStart := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	v.stakes[address] = stake
 
-	return dayStart.Unix()
+	return stake, nil
 }
 
+func (v *validatorStakes) Add(address string, amount int64) error {
+	stake, err := v.Get(address)
+	if err != nil {
+		return err
+	}
+
+	v.stakes[address] = stake + amount
+
+	return nil
+}
+
+// startCommit commits the periods in order. It stops at the first failed
+// commit: the periods after it must not move the checkpoint past the failed
+// one, the scan resumes from it instead.
 func (p *workerScan) startCommit(wg *sync.WaitGroup) (chan *db.PgDBCommit, chan error) {
 	wg.Add(1)
 
-	commitChan := make(chan *db.PgDBCommit, 64)
+	commitChan := make(chan *db.PgDBCommit, p.config.CommitChanSize)
 	errorChan := make(chan error, 1)
 	startTime := time.Now()
 
 	go func() {
+		defer wg.Done()
+
 		for {
 			commit := <-commitChan
 
 			if commit == nil {
 				p.log.Infof("commitChan closed")
-				wg.Done()
 				return
 			}
 
-			if err := store.Postgres.Commit(commit); err != nil {
+			if err := p.postgres.Commit(commit); err != nil {
 				p.log.Errorf("commit failed: %v", err)
 				errorChan <- err
+				return
 			}
 
 			processDuration := time.Since(startTime)
@@ -83,7 +156,30 @@ func (p *workerScan) startCommit(wg *sync.WaitGroup) (chan *db.PgDBCommit, chan
 	return commitChan, errorChan
 }
 
-func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
+// stopCommit waits for the queued periods to be committed, then stops the
+// commit goroutine. It returns the error of a failed commit.
+func (p *workerScan) stopCommit(wg *sync.WaitGroup, commitChan chan *db.PgDBCommit, errorChan chan error) error {
+	select {
+	case commitChan <- nil:
+	case err := <-errorChan:
+		return err
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errorChan:
+		return err
+	default:
+		return nil
+	}
+}
+
+// FetchBlockchain scans the blocks after the checkpoint up to the top of the
+// node and commits every completed day. The blocks of the last day are not
+// committed yet, they are returned as the pending changes once the committed
+// days are written.
+func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) (*auditPending, error) {
 	defer p.log.Infof("FetchBlockchain exited")
 
 	var commitWg sync.WaitGroup
@@ -94,8 +190,8 @@ func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
 	var lastBlockHeight int64
 	var globalState *model.GlobalState
 
-	if state, err := store.Postgres.GetTopGlobalState(); err != nil {
-		return fmt.Errorf("getTopGlobalState failed: %v", err)
+	if state, err := p.postgres.GetTopGlobalState(); err != nil {
+		return nil, fmt.Errorf("getTopGlobalState failed: %v", err)
 	} else {
 		if state != nil {
 			globalState = state
@@ -104,36 +200,53 @@ func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
 		}
 	}
 
-	topBlockInfo, err := store.Postgres.GetTopBlock()
+	checkpoint, err := p.postgres.GetCheckpoint(model.CheckpointChainscan)
 
 	if err != nil {
-		return fmt.Errorf("getTopBlock failed: %v", err)
+		return nil, fmt.Errorf("getCheckpoint failed: %v", err)
 	}
 
-	if topBlockInfo != nil {
-		height = topBlockInfo.Height
-		//beginHeight = int(height)
-		p.log.Infof("top block height: %v", height)
+	if checkpoint != nil {
+		height = checkpoint.Height
+		p.log.Infof("checkpoint height: %v", height)
+	} else {
+		// databases committed before checkpoints were written resume from the
+		// top block
+		topBlockInfo, err := p.postgres.GetTopBlock()
+
+		if err != nil {
+			return nil, fmt.Errorf("getTopBlock failed: %v", err)
+		}
+
+		if topBlockInfo != nil {
+			height = topBlockInfo.Height
+			//beginHeight = int(height)
+			p.log.Infof("top block height: %v", height)
+		}
 	}
 
 	blockchainInfo, err := p.reader.GetBlockchainInfo()
 
 	if err != nil {
-		return fmt.Errorf("getBlockchainInfo failed: %v", err)
+		return nil, fmt.Errorf("getBlockchainInfo failed: %v", err)
 	}
 
 	if blockchainInfo.IsPruned {
-		return fmt.Errorf("blockchain is pruned")
+		return nil, fmt.Errorf("blockchain is pruned")
 	}
 
 	lastBlockHeight = int64(blockchainInfo.LastBlockHeight)
 
 	var lastTimeIndex int64
+	var lastBlockTime uint32
+	// first block of the period being merged
+	var startHeight int64
 	txMerger := model.NewTxMerger()
+	stakes := newValidatorStakes(p.postgres)
 
 	IsInitial := false
 
-	group, _ := p.reader.CreateGroup(height+1, "pg_gatherer")
+	group, _ := p.reader.CreateGroup(height+1, p.config.ConsumerGroupID)
 
 	defer group.Close()
 
@@ -141,47 +254,83 @@ func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
 		select {
 		case <-dieChan:
 			p.log.Warn("Context cancelled, stopping FetchBlockchain")
-			return fmt.Errorf("cancelled")
+			return nil, fmt.Errorf("cancelled")
 		case err = <-commitErrChain:
 			p.log.Errorf("commit error: %v", err)
-			return err
+			return nil, err
 		case block, ok := <-group.Read():
 			if !ok {
 				p.log.Infof("top height reached: %v, commit cancelled", height)
-				commitChan <- nil // close commitChan
-				commitWg.Wait()
-				return nil
+				if err := p.stopCommit(&commitWg, commitChan, commitErrChain); err != nil {
+					return nil, err
+				}
+				return newAuditPending(height, txMerger), nil
 			}
 			height = int64(block.Height)
 
 			if height >= lastBlockHeight {
 				group.Close()
 
-				return nil
+				if err := p.stopCommit(&commitWg, commitChan, commitErrChain); err != nil {
+					return nil, err
+				}
+				return newAuditPending(height-1, txMerger), nil
 			}
 
 			timeIndex := p.GetTimeIndex(block.BlockTime)
 
 			if !IsInitial {
 				IsInitial = true
+				startHeight = height
 				lastTimeIndex = timeIndex
 				globalState.Reset(timeIndex)
 			}
 
 			// change day
 			if timeIndex != lastTimeIndex {
-				commitCtx := store.NewPgDBCommitContext(height, lastBlockHeight, lastTimeIndex, txMerger, globalState.CreateCommitCopied())
-				commitChan <- commitCtx
+				commitCtx := store.NewPgDBCommitContext(startHeight, height, lastBlockHeight, lastTimeIndex, txMerger, globalState.CreateCommitCopied())
+
+				// the commits stop at the first error, a full commitChan would
+				// never drain
+				select {
+				case commitChan <- commitCtx:
+				case err = <-commitErrChain:
+					p.log.Errorf("commit error: %v", err)
+					return nil, err
+				}
+
+				startHeight = height
 				txMerger = model.NewTxMerger()
 
 				lastTimeIndex = timeIndex
 				globalState.Reset(timeIndex)
 			}
 
+			// the parent of the first block read is unknown after a restart
+			if lastBlockTime > 0 {
+				txMerger.AddBlockInterval(timeIndex, int64(block.BlockTime)-int64(lastBlockTime), p.config.SlowBlockSeconds)
+			}
+			lastBlockTime = block.BlockTime
+
 			globalState.Txs += int64(len(block.Txs))
 			globalState.Blocks += 1
 
 			globalState.ActiveValidatorDict[block.Header.ProposerAddress] = true
+			txMerger.AddProposedBlock(timeIndex, block.Header.ProposerAddress)
+			globalState.UpdateCommittee(block.PrevCert.GetCommitters())
+
+			if block.PrevCert != nil {
+				signers, absentees, err := p.resolveCertificate(block.PrevCert)
+				if err != nil {
+					return nil, fmt.Errorf("resolveCertificate failed: %v", err)
+				}
+
+				txMerger.AddCertificate(timeIndex, signers, absentees)
+				// the certificate decides the parent block, counted on this day
+				txMerger.AddBlockRound(timeIndex, block.PrevCert.Round)
+				globalState.CertSigned += int64(len(signers))
+				globalState.CertMissed += int64(len(absentees))
+			}
 
 			for _, tx := range block.Txs {
 				globalState.Fee += tx.Fee
@@ -190,40 +339,63 @@ func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
 				case pactus.PayloadType_PAYLOAD_TYPE_TRANSFER:
 					globalState.ActiveAccountDict[tx.GetTransfer().Sender] = true
 
-					if constants.IsMainnetReserveAccount(tx.GetTransfer().Sender) {
+					if p.rules.IsReserveAccount(tx.GetTransfer().Sender) {
 						globalState.Supply += tx.GetTransfer().Amount
 						globalState.CirculatingSupply += tx.GetTransfer().Amount
 					}
 
-					if constants.IsMainnetReserveAccount(tx.GetTransfer().Receiver) {
+					if p.rules.IsReserveAccount(tx.GetTransfer().Receiver) {
 						globalState.Supply -= tx.GetTransfer().Amount
 						globalState.CirculatingSupply -= tx.GetTransfer().Amount
 					}
 
-					if constants.IsMainnetTeamHotAccount(tx.GetTransfer().Sender) {
+					if p.rules.IsTeamHotAccount(tx.GetTransfer().Sender) {
 						globalState.Supply += tx.GetTransfer().Amount
 						globalState.CirculatingSupply += tx.GetTransfer().Amount
 					}
 
-					if constants.IsMainnetTeamHotAccount(tx.GetTransfer().Receiver) {
+					if p.rules.IsTeamHotAccount(tx.GetTransfer().Receiver) {
 						globalState.Supply -= tx.GetTransfer().Amount
 						globalState.CirculatingSupply -= tx.GetTransfer().Amount
 					}
 
 					if tx.GetTransfer().Sender == constants.Treasury {
 						txMerger.AddReward(timeIndex, tx.GetTransfer().Receiver, tx.GetTransfer().Amount, block.Header.ProposerAddress)
+						globalState.Reward += tx.GetTransfer().Amount
 					} else {
 						txMerger.AddTransfer(timeIndex, tx.GetTransfer().Sender, tx.GetTransfer().Receiver, tx.GetTransfer().Amount, tx.Fee)
+						txMerger.AddPayload(timeIndex, int32(tx.PayloadType), tx.GetTransfer().Amount, tx.Fee)
 					}
 				case pactus.PayloadType_PAYLOAD_TYPE_BOND:
 					txMerger.AddBond(timeIndex, tx.GetBond().Sender, tx.GetBond().Receiver, tx.GetBond().Stake, tx.Fee)
+					if err := stakes.Add(tx.GetBond().Receiver, tx.GetBond().Stake); err != nil {
+						return nil, fmt.Errorf("getValidatorStake failed: %v", err)
+					}
+					txMerger.AddValidatorEvent(timeIndex, tx.GetBond().Receiver, model.ValidatorEventBond, height, tx.GetId(), int64(block.BlockTime), tx.GetBond().Stake, tx.GetBond().Sender)
+					txMerger.AddPayload(timeIndex, int32(tx.PayloadType), tx.GetBond().Stake, tx.Fee)
 					globalState.Stake += tx.GetBond().Stake
 					globalState.CirculatingSupply -= tx.GetBond().Stake
 				case pactus.PayloadType_PAYLOAD_TYPE_SORTITION:
+					txMerger.AddSortition(timeIndex, tx.GetSortition().Address)
+					globalState.Sortitions += 1
+					txMerger.AddPayload(timeIndex, int32(tx.PayloadType), 0, tx.Fee)
 				case pactus.PayloadType_PAYLOAD_TYPE_UNBOND:
-					txMerger.AddUnbond(timeIndex, tx.GetUnbond().Validator, height, tx.GetId(), int64(block.BlockTime))
+					stake, err := stakes.Get(tx.GetUnbond().Validator)
+					if err != nil {
+						return nil, fmt.Errorf("getValidatorStake failed: %v", err)
+					}
+					if err := txMerger.AddUnbond(timeIndex, tx.GetUnbond().Validator, height, tx.GetId(), int64(block.BlockTime), stake); err != nil {
+						return nil, fmt.Errorf("addUnbond failed: %v", err)
+					}
+					txMerger.AddValidatorEvent(timeIndex, tx.GetUnbond().Validator, model.ValidatorEventUnbond, height, tx.GetId(), int64(block.BlockTime), 0, "")
+					txMerger.AddPayload(timeIndex, int32(tx.PayloadType), 0, tx.Fee)
 				case pactus.PayloadType_PAYLOAD_TYPE_WITHDRAW:
 					txMerger.AddWithdraw(timeIndex, tx.GetWithdraw().ValidatorAddress, tx.GetWithdraw().AccountAddress, tx.GetWithdraw().Amount, tx.Fee)
+					if err := stakes.Add(tx.GetWithdraw().ValidatorAddress, -(tx.GetWithdraw().Amount + tx.Fee)); err != nil {
+						return nil, fmt.Errorf("getValidatorStake failed: %v", err)
+					}
+					txMerger.AddValidatorEvent(timeIndex, tx.GetWithdraw().ValidatorAddress, model.ValidatorEventWithdraw, height, tx.GetId(), int64(block.BlockTime), tx.GetWithdraw().Amount, tx.GetWithdraw().AccountAddress)
+					txMerger.AddPayload(timeIndex, int32(tx.PayloadType), tx.GetWithdraw().Amount, tx.Fee)
 					globalState.Stake -= tx.GetWithdraw().Amount
 					globalState.CirculatingSupply += tx.GetWithdraw().Amount
 				case pactus.PayloadType_PAYLOAD_TYPE_BATCH_TRANSFER:
@@ -231,33 +403,47 @@ func (p *workerScan) FetchBlockchain(dieChan <-chan struct{}) error {
 
 					globalState.ActiveAccountDict[bt.Sender] = true
 
+					if bt.Sender != constants.Treasury {
+						var volume int64
+						for _, recipient := range bt.Recipients {
+							volume += recipient.Amount
+						}
+
+						txMerger.AddPayload(timeIndex, int32(tx.PayloadType), volume, tx.Fee)
+					}
+
+					recipients := make([]model.TransferRecipient, 0, len(bt.Recipients))
+
 					for _, recipient := range bt.Recipients {
-						if constants.IsMainnetReserveAccount(bt.Sender) {
+						if p.rules.IsReserveAccount(bt.Sender) {
 							globalState.Supply += recipient.Amount
 							globalState.CirculatingSupply += recipient.Amount
 						}
 
-						if constants.IsMainnetReserveAccount(recipient.Receiver) {
+						if p.rules.IsReserveAccount(recipient.Receiver) {
 							globalState.Supply -= recipient.Amount
 							globalState.CirculatingSupply -= recipient.Amount
 						}
 
-						if constants.IsMainnetTeamHotAccount(bt.Sender) {
+						if p.rules.IsTeamHotAccount(bt.Sender) {
 							globalState.Supply += recipient.Amount
 							globalState.CirculatingSupply += recipient.Amount
 						}
 
-						if constants.IsMainnetTeamHotAccount(recipient.Receiver) {
+						if p.rules.IsTeamHotAccount(recipient.Receiver) {
 							globalState.Supply -= recipient.Amount
 							globalState.CirculatingSupply -= recipient.Amount
 						}
 
 						if bt.Sender == constants.Treasury {
 							txMerger.AddReward(timeIndex, recipient.Receiver, recipient.Amount, block.Header.ProposerAddress)
+							globalState.Reward += recipient.Amount
 						} else {
-							txMerger.AddTransfer(timeIndex, bt.Sender, recipient.Receiver, recipient.Amount, tx.Fee)
+							recipients = append(recipients, model.TransferRecipient{Receiver: recipient.Receiver, Amount: recipient.Amount})
 						}
 					}
+
+					txMerger.AddBatchTransfer(timeIndex, bt.Sender, recipients, tx.Fee)
 				}
 			}
 		}
@@ -2,6 +2,8 @@ package webapi
 
 type Config struct {
 	HttpListen string `mapstructure:"http_listen"`
+	// bearer token of the admin api, the admin api is disabled when empty
+	AdminToken string `mapstructure:"admin_token"`
 }
 
 func NewDefaultConfig() *Config {
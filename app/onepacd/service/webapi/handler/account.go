@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupAccount(group *gin.RouterGroup) {
+	accountRoute := group.Group("/account")
+
+	accountRoute.GET("/activity", func(c *gin.Context) {
+		httpResp := &api.GetAccountActivityResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetAccountActivityRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		activities, err := networkData(c).GetAccountActivity(int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetAccountActivity failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.AccountActivityData, 0, len(activities))
+
+		for _, a := range activities {
+			httpResp.Lines = append(httpResp.Lines, a.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+
+	accountRoute.GET("/retention", func(c *gin.Context) {
+		httpResp := &api.GetAccountRetentionResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetAccountRetentionRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Weeks <= 0 || req.Weeks > 52 {
+			req.Weeks = 12 // default to 12 weeks
+		}
+
+		cohorts, err := networkData(c).GetAccountRetention(int64(req.Weeks))
+
+		if err != nil {
+			log.Errorf("GetAccountRetention failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Cohorts = make([]*api.AccountCohortData, 0, len(cohorts))
+
+		for _, cohort := range cohorts {
+			httpResp.Cohorts = append(httpResp.Cohorts, cohort.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupAddress(group *gin.RouterGroup) {
+	addressRoute := group.Group("/address/:address")
+
+	addressRoute.GET("/flows", func(c *gin.Context) {
+		httpResp := &api.GetAddressFlowsResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetAddressFlowsRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		flows, err := networkData(c).GetAddressFlows(c.Param("address"), int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetAddressFlows failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.AddressFlowData, 0, len(flows))
+
+		for _, f := range flows {
+			httpResp.Lines = append(httpResp.Lines, f.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+
+	addressRoute.GET("/counterparties", func(c *gin.Context) {
+		httpResp := &api.GetAddressCounterpartiesResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetAddressCounterpartiesRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		if req.Limit <= 0 || req.Limit > 100 {
+			req.Limit = 10
+		}
+
+		counterparties, err := networkData(c).GetAddressCounterparties(c.Param("address"), int64(req.Days), int(req.Limit))
+
+		if err != nil {
+			log.Errorf("GetAddressCounterparties failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Counterparties = make([]*api.AddressCounterpartyData, 0, len(counterparties))
+
+		for _, cp := range counterparties {
+			httpResp.Counterparties = append(httpResp.Counterparties, cp.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
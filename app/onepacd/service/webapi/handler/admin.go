@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/middleware"
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+type ScanTrigger interface {
+	TriggerScan() bool
+}
+
+func SetupAdmin(group *gin.RouterGroup, adminToken string, scanTriggers map[string]ScanTrigger) {
+	adminRoute := group.Group("/admin", middleware.AdminAuth(adminToken))
+
+	adminRoute.POST("/scan", func(c *gin.Context) {
+		httpResp := &api.TriggerScanResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.TriggerScanRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		network := networkStore(c).Name
+
+		scanTrigger, ok := scanTriggers[network]
+		if !ok {
+			httpResp.Code = model.Code_NotFound
+			return
+		}
+
+		httpResp.Queued = scanTrigger.TriggerScan()
+
+		log.Infof("admin scan trigger from %s, network=%s, queued=%v", c.ClientIP(), network, httpResp.Queued)
+
+		httpResp.Code = model.Code_Success
+	})
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupAudit(group *gin.RouterGroup) {
+	auditRoute := group.Group("/audit")
+
+	auditRoute.GET("/latest", func(c *gin.Context) {
+		httpResp := &api.GetLatestAuditResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetLatestAuditRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		run, err := networkData(c).GetLatestAuditRun()
+
+		if err != nil {
+			log.Errorf("GetLatestAuditRun failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		if run == nil {
+			httpResp.Code = model.Code_NotFound
+			return
+		}
+
+		httpResp.Audit = run.ToProto()
+		httpResp.Code = model.Code_Success
+	})
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupBlock(group *gin.RouterGroup) {
+	blockRoute := group.Group("/block")
+
+	blockRoute.GET("/timing", func(c *gin.Context) {
+		httpResp := &api.GetBlockTimingResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetBlockTimingRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		timings, err := networkData(c).GetBlockTiming(int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetBlockTiming failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.BlockTimingData, 0, len(timings))
+
+		for _, t := range timings {
+			httpResp.Lines = append(httpResp.Lines, t.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
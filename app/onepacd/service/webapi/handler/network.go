@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	networkStoreKey = "network_store"
+)
+
+// Network resolves the network query parameter to its stores, the first
+// configured network serves requests without one.
+func Network() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := store.GetNetwork(c.Query("network"))
+
+		if ns == nil {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"code":  http.StatusNotFound,
+				"error": "unknown network",
+			})
+			return
+		}
+
+		c.Set(networkStoreKey, ns)
+		c.Next()
+	}
+}
+
+func networkStore(c *gin.Context) *store.NetworkStore {
+	return c.MustGet(networkStoreKey).(*store.NetworkStore)
+}
+
+func networkData(c *gin.Context) store.IStore {
+	return networkStore(c).Store
+}
+
+// storeErrorCode is the response code of a failed store query, queries the
+// backend of the network cannot answer are not database errors
+func storeErrorCode(err error) int32 {
+	if errors.Is(err, store.ErrUnsupported) {
+		return model.Code_Unsupported
+	}
+
+	return model.Code_DatabaseError
+}
@@ -1,16 +1,20 @@
 package handler
 
 import (
-	"net/http"
 	"slices"
+	"time"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
-	"github.com/1pactus/1pactus-react/app/onepacd/store"
+	storemodel "github.com/1pactus/1pactus-react/app/onepacd/store/model"
 	"github.com/1pactus/1pactus-react/log"
 	"github.com/1pactus/1pactus-react/proto/gen/go/api"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	maxDistinctActiveDays = 366
+)
+
 func SetupNetworkStatus(group *gin.RouterGroup) {
 	//networkStatusRoute := group.Group("/network_status")
 
@@ -19,24 +23,14 @@ func SetupNetworkStatus(group *gin.RouterGroup) {
 		datatype := "json"
 
 		defer func() {
-			if httpResp.Msg == "" {
-				httpResp.Msg = model.ErrorFromCode(httpResp.Code).Error()
-			}
-
-			switch datatype {
-			case "json":
-				c.JSON(http.StatusOK, httpResp)
-			case "pb":
-				c.ProtoBuf(http.StatusOK, httpResp)
-			default:
-				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid datatype"})
-			}
+			writeResponse(c, datatype, httpResp)
 		}()
 
 		var req api.GetNetworkHealthRequest
 
 		if err := c.ShouldBindQuery(&req); err != nil {
 			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
 			return
 		}
 
@@ -48,12 +42,10 @@ func SetupNetworkStatus(group *gin.RouterGroup) {
 			req.Days = 30 // default to 30 days
 		}
 
-		//stats, err := store.Mongo.GetNetworkGlobalStats(int64(req.Days))
-
-		stats, err := store.Postgres.GetNetworkGlobalStats(int64(req.Days))
+		stats, err := networkData(c).GetNetworkGlobalStats(int64(req.Days))
 
 		if err != nil {
-			httpResp.Code = model.Code_InternalError
+			httpResp.Code = storeErrorCode(err)
 			return
 		}
 
@@ -65,11 +57,60 @@ func SetupNetworkStatus(group *gin.RouterGroup) {
 			httpResp.Lines = append(httpResp.Lines, s.ToProto())
 		}
 
-		if err != nil {
-			httpResp.Code = model.Code_InternalError
+		httpResp.Code = model.Code_Success
+	})
+
+	group.GET("/network_actives", func(c *gin.Context) {
+		httpResp := &api.GetDistinctActiveResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetDistinctActiveRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		// a custom range takes precedence over days
+		to := storemodel.GetTimeIndex(time.Now().Unix())
+		if req.To > 0 {
+			to = storemodel.GetTimeIndex(int64(req.To))
+		}
+
+		var from int64
+		if req.From > 0 {
+			from = storemodel.GetTimeIndex(int64(req.From))
 		} else {
-			httpResp.Code = model.Code_Success
+			if req.Days <= 0 {
+				req.Days = 7 // default to 7 days
+			}
+
+			from = to - int64(req.Days-1)*storemodel.TimeIndexInterval
+		}
+
+		if from > to || (to-from)/storemodel.TimeIndexInterval >= maxDistinctActiveDays {
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		actives, err := networkData(c).GetDistinctActive(from, to)
+
+		if err != nil {
+			log.Errorf("GetDistinctActive failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
 		}
 
+		httpResp.Data = actives.ToProto()
+		httpResp.Code = model.Code_Success
 	})
 }
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type apiResponse interface {
+	proto.Message
+	GetCode() int32
+	GetMsg() string
+}
+
+// writeResponse renders resp as json or protobuf and fills an empty msg field
+// from the response code.
+func writeResponse(c *gin.Context, datatype string, resp apiResponse) {
+	if resp.GetMsg() == "" {
+		msg := resp.ProtoReflect()
+		if field := msg.Descriptor().Fields().ByName("msg"); field != nil {
+			msg.Set(field, protoreflect.ValueOfString(model.ErrorFromCode(resp.GetCode()).Error()))
+		}
+	}
+
+	switch datatype {
+	case "json":
+		c.JSON(http.StatusOK, resp)
+	case "pb":
+		c.ProtoBuf(http.StatusOK, resp)
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid datatype"})
+	}
+}
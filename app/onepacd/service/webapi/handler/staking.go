@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStaking(group *gin.RouterGroup) {
+	stakingRoute := group.Group("/staking")
+
+	stakingRoute.GET("/yield", func(c *gin.Context) {
+		httpResp := &api.GetStakingYieldResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetStakingYieldRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		if req.Stake < 0 {
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		stakingYield, err := networkData(c).GetStakingYield(int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetStakingYield failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Data = stakingYield.ToProto()
+		httpResp.ProjectedYearlyReward, httpResp.ProjectedDailyReward = stakingYield.ProjectedReward(req.Stake)
+		httpResp.Code = model.Code_Success
+	})
+}
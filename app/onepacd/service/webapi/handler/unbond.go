@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupUnbond(group *gin.RouterGroup) {
+	unbondRoute := group.Group("/unbond")
+
+	unbondRoute.GET("/forecast", func(c *gin.Context) {
+		httpResp := &api.GetUnbondForecastResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetUnbondForecastRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		forecast, err := networkData(c).GetUnbondForecast(int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetUnbondForecast failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Withdrawable = forecast.Withdrawable
+		httpResp.Lines = make([]*api.UnbondForecastData, 0, len(forecast.Days))
+
+		for _, d := range forecast.Days {
+			httpResp.Lines = append(httpResp.Lines, d.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupValidator(group *gin.RouterGroup) {
+	validatorRoute := group.Group("/validator")
+
+	validatorRoute.GET("/leaderboard", func(c *gin.Context) {
+		httpResp := &api.GetValidatorLeaderboardResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetValidatorLeaderboardRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		if req.Limit <= 0 || req.Limit > 100 {
+			req.Limit = 10
+		}
+
+		validators, err := networkData(c).GetValidatorLeaderboard(int64(req.Days), int(req.Limit), req.OrderBy)
+
+		if err != nil {
+			log.Errorf("GetValidatorLeaderboard failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Validators = make([]*api.ValidatorStatData, 0, len(validators))
+
+		for _, v := range validators {
+			httpResp.Validators = append(httpResp.Validators, v.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+
+	validatorRoute.GET("/:address/history", func(c *gin.Context) {
+		httpResp := &api.GetValidatorHistoryResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetValidatorHistoryRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		history, err := networkData(c).GetValidatorHistory(c.Param("address"), int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetValidatorHistory failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.ValidatorStatData, 0, len(history))
+
+		for _, v := range history {
+			httpResp.Lines = append(httpResp.Lines, v.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+	validatorRoute.GET("/uptime", func(c *gin.Context) {
+		httpResp := &api.GetValidatorUptimeResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetValidatorUptimeRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		if req.Limit <= 0 || req.Limit > 100 {
+			req.Limit = 10
+		}
+
+		uptimes, err := networkData(c).GetValidatorUptime(int64(req.Days), int(req.Limit), req.OrderBy)
+
+		if err != nil {
+			log.Errorf("GetValidatorUptime failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Validators = make([]*api.ValidatorUptimeData, 0, len(uptimes))
+
+		for _, u := range uptimes {
+			httpResp.Validators = append(httpResp.Validators, u.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+	validatorRoute.GET("/churn", func(c *gin.Context) {
+		httpResp := &api.GetValidatorChurnResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetValidatorChurnRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		churn, err := networkData(c).GetValidatorChurn(int64(req.Days))
+
+		if err != nil {
+			log.Errorf("GetValidatorChurn failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.ValidatorChurnData, 0, len(churn))
+
+		for _, v := range churn {
+			httpResp.Lines = append(httpResp.Lines, v.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+
+	validatorRoute.GET("/:address/timeline", func(c *gin.Context) {
+		httpResp := &api.GetValidatorTimelineResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetValidatorTimelineRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		lifecycle, events, err := networkData(c).GetValidatorTimeline(c.Param("address"))
+
+		if err != nil {
+			log.Errorf("GetValidatorTimeline failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		if lifecycle == nil {
+			httpResp.Code = model.Code_NotFound
+			return
+		}
+
+		httpResp.BondTimeIndex = uint32(lifecycle.BondTimeIndex)
+		httpResp.UnbondTimeIndex = uint32(lifecycle.UnbondTimeIndex)
+		httpResp.WithdrawTimeIndex = uint32(lifecycle.WithdrawTimeIndex)
+		httpResp.Events = make([]*api.ValidatorEventData, 0, len(events))
+
+		for _, e := range events {
+			httpResp.Events = append(httpResp.Events, e.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
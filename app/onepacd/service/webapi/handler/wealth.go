@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/model"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupWealth(group *gin.RouterGroup) {
+	wealthRoute := group.Group("/wealth")
+
+	wealthRoute.GET("/richlist", func(c *gin.Context) {
+		httpResp := &api.GetRichListResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetRichListRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Limit <= 0 || req.Limit > 100 {
+			req.Limit = 10
+		}
+
+		accounts, err := networkData(c).GetRichList(int(req.Limit), req.ExcludeReserve)
+
+		if err != nil {
+			log.Errorf("GetRichList failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Accounts = make([]*api.RichListEntryData, 0, len(accounts))
+
+		for _, a := range accounts {
+			httpResp.Accounts = append(httpResp.Accounts, a.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+
+	wealthRoute.GET("/distribution", func(c *gin.Context) {
+		httpResp := &api.GetWealthDistributionResponse{}
+		datatype := "json"
+
+		defer func() {
+			writeResponse(c, datatype, httpResp)
+		}()
+
+		var req api.GetWealthDistributionRequest
+
+		if err := c.ShouldBindQuery(&req); err != nil {
+			log.Error("failed to bind query params: ", err)
+			httpResp.Code = model.Code_InvalidParams
+			return
+		}
+
+		if req.Datatype != "" {
+			datatype = req.Datatype
+		}
+
+		if req.Days <= 0 {
+			req.Days = 30 // default to 30 days
+		}
+
+		distributions, err := networkData(c).GetWealthDistribution(int64(req.Days), req.ExcludeReserve)
+
+		if err != nil {
+			log.Errorf("GetWealthDistribution failed: %v", err)
+			httpResp.Code = storeErrorCode(err)
+			return
+		}
+
+		httpResp.Lines = make([]*api.WealthDistributionData, 0, len(distributions))
+
+		for _, d := range distributions {
+			httpResp.Lines = append(httpResp.Lines, d.ToProto())
+		}
+
+		httpResp.Code = model.Code_Success
+	})
+}
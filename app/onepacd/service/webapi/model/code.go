@@ -18,6 +18,7 @@ const (
 	Code_Busy          = code_Common_Start + 6
 	Code_UnknownError  = code_Common_Start + 7
 	Code_DatabaseError = code_Common_Start + 8
+	Code_Unsupported   = code_Common_Start + 9
 
 	// Assets
 
@@ -42,6 +43,7 @@ var (
 		Code_Busy:          errors.New("busy"),
 		Code_UnknownError:  unknownError,
 		Code_DatabaseError: errors.New("database error"),
+		Code_Unsupported:   errors.New("not supported by the store backend"),
 	}
 
 	allCodeToErrors = map[int]error{}
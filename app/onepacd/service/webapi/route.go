@@ -15,9 +15,18 @@ func (s *WebApiService) setupRoute(r *gin.Engine) error {
 		})
 	})
 
-	groupApi := r.Group("/api")
+	groupApi := r.Group("/api", handler.Network())
 	{
 		handler.SetupNetworkStatus(groupApi)
+		handler.SetupBlock(groupApi)
+		handler.SetupAddress(groupApi)
+		handler.SetupUnbond(groupApi)
+		handler.SetupValidator(groupApi)
+		handler.SetupStaking(groupApi)
+		handler.SetupAudit(groupApi)
+		handler.SetupWealth(groupApi)
+		handler.SetupAccount(groupApi)
+		handler.SetupAdmin(groupApi, s.config.AdminToken, s.scanTriggers)
 	}
 
 	r.NoRoute(func(c *gin.Context) {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/handler"
 	"github.com/1pactus/1pactus-react/app/onepacd/service/webapi/middleware"
 	"github.com/1pactus/1pactus-react/lifecycle"
 	"github.com/1pactus/1pactus-react/log"
@@ -17,13 +18,16 @@ type WebApiService struct {
 	log    log.ILogger
 	config *Config
 	mode   string
+
+	scanTriggers map[string]handler.ScanTrigger
 }
 
-func NewWebApiService(appLifeCycle *lifecycle.AppLifeCycle, mode string, config *Config) *WebApiService {
+func NewWebApiService(appLifeCycle *lifecycle.AppLifeCycle, mode string, config *Config, scanTriggers map[string]handler.ScanTrigger) *WebApiService {
 	return &WebApiService{
 		ServiceLifeCycle: lifecycle.NewServiceLifeCycle(appLifeCycle),
 		log:              log.WithKv("service", "webapi"),
 		config:           config,
+		scanTriggers:     scanTriggers,
 	}
 }
 
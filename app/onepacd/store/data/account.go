@@ -0,0 +1,31 @@
+package data
+
+// AccountBalanceData is a document of the account_balance collection, or of
+// account_balance_index where Balance is the balance change of the day
+type AccountBalanceData struct {
+	TimeIndex int64  `bson:"time_index,omitempty"`
+	Address   string `bson:"address"`
+	Balance   int64  `bson:"balance"`
+}
+
+// ValidatorStakeData is a document of the validator_stake collection, the
+// unbond fields are set once the validator unbonded
+type ValidatorStakeData struct {
+	Address  string `bson:"address"`
+	Stake    int64  `bson:"stake"`
+	StakeMax int64  `bson:"stake_max"`
+
+	UnbondTimeIndex int64  `bson:"unbond_time_index,omitempty"`
+	UnbondHeight    int64  `bson:"unbond_height,omitempty"`
+	UnbondHash      string `bson:"unbond_hash,omitempty"`
+	UnbondTime      int64  `bson:"unbond_time,omitempty"`
+}
+
+// UnbondData is a document of the address_unbond_index collection
+type UnbondData struct {
+	TimeIndex int64  `bson:"time_index"`
+	Address   string `bson:"address"`
+	Height    int64  `bson:"height"`
+	Hash      string `bson:"hash"`
+	Time      int64  `bson:"time"`
+}
@@ -0,0 +1,16 @@
+package data
+
+// AddressIndexData is a document of the address_* index collections: the
+// amounts an address sent, received or was rewarded during a day, in total
+// and by counterparty. Each collection fills one of the amount and map pairs.
+type AddressIndexData struct {
+	TimeIndex int64  `bson:"time_index"`
+	Address   string `bson:"address"`
+
+	SenderAmount   int64            `bson:"sender_amount,omitempty"`
+	SenderMap      map[string]int64 `bson:"sender_map,omitempty"`
+	ReceiverAmount int64            `bson:"receiver_amount,omitempty"`
+	ReceiverMap    map[string]int64 `bson:"receiver_map,omitempty"`
+	RewardAmount   int64            `bson:"reward_amount,omitempty"`
+	RewardMap      map[string]int64 `bson:"reward_map,omitempty"`
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+)
+
+// CheckpointData is a document of the checkpoint collection, see
+// model.Checkpoint
+type CheckpointData struct {
+	Name      string `bson:"name"`
+	Height    int64  `bson:"height"`
+	TimeIndex int64  `bson:"time_index"`
+	UpdatedAt int64  `bson:"updated_at"`
+}
+
+func (c *CheckpointData) ToModel() *model.Checkpoint {
+	return &model.Checkpoint{
+		Name:      c.Name,
+		Height:    c.Height,
+		TimeIndex: c.TimeIndex,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
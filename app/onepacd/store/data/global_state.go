@@ -1,26 +1,16 @@
 package data
 
 import (
-	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-type GlobalState struct {
-	TimeIndex         uint32 `bson:"time_index"`
-	Stake             int64  `bson:"total_stake"`
-	Supply            int64  `bson:"total_supply"`
-	CirculatingSupply int64  `bson:"circulating_supply"`
-	Txs               int64  `bson:"txs"`
-	Blocks            int64  `bson:"blocks"`
-	Fee               int64  `bson:"fee"`
-
-	ActiveValidator map[string]bool
-	ActiveAccount   map[string]bool
-}
-
+// GlobalStateData is a document of the global_state_index collection. The
+// fields after ActiveAccountCount were added with the postgres store, older
+// documents decode them as zero.
 type GlobalStateData struct {
 	ID                *primitive.ObjectID `bson:"_id,omitempty"`
-	TimeIndex         uint32              `bson:"time_index"`
+	TimeIndex         int64               `bson:"time_index"`
 	Stake             int64               `bson:"total_stake"`
 	Supply            int64               `bson:"total_supply"`
 	CirculatingSupply int64               `bson:"circulating_supply"`
@@ -30,44 +20,17 @@ type GlobalStateData struct {
 
 	ActiveValidatorCount int64 `bson:"active_validator_count"`
 	ActiveAccountCount   int64 `bson:"active_account_count"`
-}
 
-func (g *GlobalStateData) ToProto() *api.NetworkStatusData {
-	return &api.NetworkStatusData{
-		TimeIndex:         g.TimeIndex,
-		Stake:             g.Stake,
-		Supply:            g.Supply,
-		CirculatingSupply: g.CirculatingSupply,
-		Txs:               g.Txs,
-		Blocks:            g.Blocks,
-		Fee:               g.Fee,
-		ActiveValidator:   g.ActiveValidatorCount,
-		ActiveAccount:     g.ActiveAccountCount,
-	}
-}
-
-func NewGlobalStateData() *GlobalState {
-	return &GlobalState{
-		ActiveValidator: make(map[string]bool),
-		ActiveAccount:   make(map[string]bool),
-	}
+	Reward            int64 `bson:"reward"`
+	Sortitions        int64 `bson:"sortitions"`
+	CommitteeSize     int64 `bson:"committee_size"`
+	CommitteeTurnover int64 `bson:"committee_turnover"`
+	CertSigned        int64 `bson:"cert_signed"`
+	CertMissed        int64 `bson:"cert_missed"`
 }
 
-func (g *GlobalState) Reset(timeIndex uint32) {
-	g.TimeIndex = timeIndex
-	g.Blocks = 0
-	g.Txs = 0
-	g.Fee = 0
-	clear(g.ActiveValidator)
-	clear(g.ActiveAccount)
-}
-
-func (g *GlobalState) CreateDBData() *GlobalStateData {
-	if g == nil {
-		return nil
-	}
-
-	copy := &GlobalStateData{
+func NewGlobalStateData(g *model.GlobalState) *GlobalStateData {
+	return &GlobalStateData{
 		TimeIndex:            g.TimeIndex,
 		Stake:                g.Stake,
 		Supply:               g.Supply,
@@ -75,13 +38,35 @@ func (g *GlobalState) CreateDBData() *GlobalStateData {
 		Txs:                  g.Txs,
 		Blocks:               g.Blocks,
 		Fee:                  g.Fee,
-		ActiveValidatorCount: int64(len(g.ActiveValidator)),
-		ActiveAccountCount:   int64(len(g.ActiveAccount)),
-		/*StakeChange:     g.StakeChange,
-		SupplyChange:    g.SupplyChange,
-		ValidatorsCount: g.ValidatorsCount,
-		AccountsCount:   g.AccountsCount,*/
+		ActiveValidatorCount: g.ActiveValidator,
+		ActiveAccountCount:   g.ActiveAccount,
+		Reward:               g.Reward,
+		Sortitions:           g.Sortitions,
+		CommitteeSize:        g.CommitteeSize,
+		CommitteeTurnover:    g.CommitteeTurnover,
+		CertSigned:           g.CertSigned,
+		CertMissed:           g.CertMissed,
 	}
+}
+
+func (g *GlobalStateData) ToModel() *model.GlobalState {
+	state := model.NewGlobalState()
+
+	state.TimeIndex = g.TimeIndex
+	state.Stake = g.Stake
+	state.Supply = g.Supply
+	state.CirculatingSupply = g.CirculatingSupply
+	state.Txs = g.Txs
+	state.Blocks = g.Blocks
+	state.Fee = g.Fee
+	state.ActiveValidator = g.ActiveValidatorCount
+	state.ActiveAccount = g.ActiveAccountCount
+	state.Reward = g.Reward
+	state.Sortitions = g.Sortitions
+	state.CommitteeSize = g.CommitteeSize
+	state.CommitteeTurnover = g.CommitteeTurnover
+	state.CertSigned = g.CertSigned
+	state.CertMissed = g.CertMissed
 
-	return copy
+	return state
 }
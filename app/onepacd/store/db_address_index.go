@@ -2,478 +2,171 @@ package store
 
 import (
 	"context"
-	"fmt"
-	"log"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/store/data"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func createAddressDataIndex(collection *mongo.Collection) error {
-	indexModel := mongo.IndexModel{
-		Keys: bson.D{{Key: "address", Value: 1}},
-		Options: options.Index().
-			SetUnique(true),
-	}
-
-	_, err := collection.Indexes().CreateOne(context.Background(), indexModel)
-	if err != nil {
-		return err
-	}
-
-	return nil
+// addressFlow is an amount moved between two addresses during a day
+type addressFlow struct {
+	timeIndex int64
+	from      string
+	to        string
+	amount    int64
 }
 
-func createAddressTimeIndexDataIndex(collection *mongo.Collection) error {
-	indexModel := mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "time_index", Value: 1},
-			{Key: "address", Value: 1},
-		},
-		Options: options.Index().
-			SetUnique(false),
-	}
-
-	_, err := collection.Indexes().CreateOne(context.Background(), indexModel)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func (c *DbClient) createAddressIndex() error {
-	c.collection.address_transfer_sender_index = c.database.Collection("address_transfer_sender_index")
-	c.collection.address_transfer_receiver_index = c.database.Collection("address_transfer_receiver_index")
-	c.collection.address_transfer_reward_index = c.database.Collection("address_transfer_reward_index")
-	c.collection.address_bond_sender_index = c.database.Collection("address_bond_sender_index")
-	c.collection.address_bond_receiver_index = c.database.Collection("address_bond_receiver_index")
-	c.collection.address_unbond_index = c.database.Collection("address_unbond_index")
-	c.collection.address_withdraw_sender_index = c.database.Collection("address_withdraw_sender_index")
-	c.collection.address_withdraw_receiver_index = c.database.Collection("address_withdraw_receiver_index")
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_transfer_sender_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_transfer_receiver_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_transfer_reward_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_bond_sender_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_bond_receiver_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_unbond_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_withdraw_sender_index); err != nil {
-		return err
-	}
-
-	if err := createAddressTimeIndexDataIndex(c.collection.address_withdraw_receiver_index); err != nil {
-		return err
-	}
-
-	return nil
+type addressIndexKey struct {
+	timeIndex int64
+	address   string
 }
 
-func (c *DbClient) updateSenderTransfers(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.transferSender) == 0 {
-		return nil
-	}
-
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.transferSender {
-		for sender, transferSender := range record {
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "sender_amount", Value: transferSender.total})
-
-			for targetAddress, amount := range transferSender.addresses {
-				key := fmt.Sprintf("sender_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: sender},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
+// addressIndexWrites adds the flows to the documents of their address, in
+// amountField and by counterparty in mapField. The address of a flow is its
+// sender, or its receiver when byReceiver is set.
+func addressIndexWrites(flows []addressFlow, byReceiver bool, amountField string, mapField string) []mongo.WriteModel {
+	incs := make(map[addressIndexKey]bson.D)
+	totals := make(map[addressIndexKey]int64)
+
+	for _, flow := range flows {
+		address, counterparty := flow.from, flow.to
+		if byReceiver {
+			address, counterparty = flow.to, flow.from
 		}
-	}
 
-	result, err := c.collection.address_transfer_sender_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
+		key := addressIndexKey{timeIndex: flow.timeIndex, address: address}
+		totals[key] += flow.amount
+		incs[key] = append(incs[key], bson.E{Key: mapField + "." + counterparty, Value: flow.amount})
 	}
 
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateSenderTransfers: No data inserted or modified")
-	}
+	writes := make([]mongo.WriteModel, 0, len(incs))
 
-	return nil
-}
+	for key, inc := range incs {
+		inc = append(inc, bson.E{Key: amountField, Value: totals[key]})
 
-func (c *DbClient) updateReceiverTransfers(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.transferReceiver) == 0 {
-		return nil
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "time_index", Value: key.timeIndex},
+				{Key: "address", Value: key.address},
+			}).
+			SetUpdate(bson.D{{Key: "$inc", Value: inc}}).
+			SetUpsert(true))
 	}
 
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.transferReceiver {
-		for receiver, transferReceiver := range record {
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "receiver_amount", Value: transferReceiver.total})
-
-			for targetAddress, amount := range transferReceiver.addresses {
-				key := fmt.Sprintf("receiver_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: receiver},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
-	}
-
-	result, err := c.collection.address_transfer_receiver_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
-	}
-
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateReceiverTransfers: No data inserted or modified")
-	}
-
-	return nil
+	return writes
 }
 
-func (c *DbClient) updateRewardTransfers(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.transferReward) == 0 {
-		return nil
-	}
-
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.transferReward {
-		for rewardAddress, transferReward := range record {
-
-			// 处理 sender_map
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "reward_amount", Value: transferReward.total})
-
-			for targetAddress, amount := range transferReward.addresses {
-				key := fmt.Sprintf("reward_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: rewardAddress},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
-	}
-
-	// Execute bulk write
-	result, err := c.collection.address_transfer_reward_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
+// updateFlows writes flows to the sender and the receiver collections of a
+// transaction type
+func updateFlows(ctx context.Context, senders *mongo.Collection, receivers *mongo.Collection, flows []addressFlow) error {
+	if err := bulkWrite(ctx, senders, addressIndexWrites(flows, false, "sender_amount", "sender_map")); err != nil {
 		return err
 	}
 
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateRewardTransfers: No data inserted or modified")
-	}
-
-	return nil
+	return bulkWrite(ctx, receivers, addressIndexWrites(flows, true, "receiver_amount", "receiver_map"))
 }
 
-func (c *DbClient) updateSenderBond(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.bondSender) == 0 {
-		return nil
-	}
-
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.bondSender {
-		for sender, bondSender := range record {
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "sender_amount", Value: bondSender.total})
-
-			for targetAddress, amount := range bondSender.addresses {
-				key := fmt.Sprintf("sender_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: sender},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
-	}
-
-	// Execute bulk write
-	result, err := c.collection.address_bond_sender_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
-	}
+func (c *DbClient) updateTransfers(ctx context.Context, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().TransferTimeIndexes()
+	flows := make([]addressFlow, 0, len(rows))
 
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateSenderBond: No data inserted or modified")
+	for _, row := range rows {
+		flows = append(flows, addressFlow{timeIndex: row.TimeIndex, from: row.AddressFrom, to: row.AddressTo, amount: row.Amount})
 	}
 
-	return nil
+	return updateFlows(ctx, c.collection.address_transfer_sender_index, c.collection.address_transfer_receiver_index, flows)
 }
 
-func (c *DbClient) updateReceiverBond(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.bondReceiver) == 0 {
-		return nil
-	}
-
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.bondReceiver {
-		for receiver, bondReceiver := range record {
-			incFields := bson.D{}
+// updateRewardTransfers indexes the rewards by receiver, with the amounts by
+// proposing validator in reward_map
+func (c *DbClient) updateRewardTransfers(ctx context.Context, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorRewardTimeIndexes()
+	flows := make([]addressFlow, 0, len(rows))
 
-			incFields = append(incFields, bson.E{Key: "receiver_amount", Value: bondReceiver.total})
-
-			for targetAddress, amount := range bondReceiver.addresses {
-				key := fmt.Sprintf("receiver_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: receiver},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
+	for _, row := range rows {
+		flows = append(flows, addressFlow{timeIndex: row.TimeIndex, from: row.Address, to: row.Receiver, amount: row.Amount})
 	}
 
-	// Execute bulk write
-	result, err := c.collection.address_bond_receiver_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
-	}
-
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateReceiverBond: No data inserted or modified")
-	}
-
-	return nil
+	return bulkWrite(ctx, c.collection.address_transfer_reward_index, addressIndexWrites(flows, true, "reward_amount", "reward_map"))
 }
 
-func (c *DbClient) updateUnbondTransfers(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
+func (c *DbClient) updateBonds(ctx context.Context, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().BondTimeIndexes()
+	flows := make([]addressFlow, 0, len(rows))
 
-	if len(m.unbond) == 0 {
-		return nil
+	for _, row := range rows {
+		flows = append(flows, addressFlow{timeIndex: row.TimeIndex, from: row.AddressFrom, to: row.AddressTo, amount: row.Amount})
 	}
 
-	writeModel := make([]mongo.WriteModel, 0)
-	writeValidatorModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.unbond {
-		for rewardAddress, info := range record {
-			insert := mongo.NewInsertOneModel().
-				SetDocument(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: rewardAddress},
-					{Key: "height", Value: info.Height},
-					{Key: "hash", Value: info.Hash},
-					{Key: "time", Value: info.Time},
-				})
-
-			writeModel = append(writeModel, insert)
-
-			validatorInsert := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "address", Value: rewardAddress},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$set", Value: bson.M{
-						"unbond_time_index": timeIndex,
-						"unbond_height":     info.Height,
-						"unbond_hash":       info.Hash,
-						"unbond_time":       info.Time,
-					}},
-				}).
-				SetUpsert(true)
-
-			writeValidatorModel = append(writeValidatorModel, validatorInsert)
-		}
-	}
-
-	// Execute bulk write
-	result, err := c.collection.address_unbond_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
-	}
-
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateRewardTransfers: No data inserted or modified")
-	}
-
-	result1, err := c.collection.validator_stake.BulkWrite(c.ctx, writeValidatorModel)
-	if err != nil {
-		return err
-	}
-
-	if result1.InsertedCount == 0 && result1.ModifiedCount == 0 && result1.UpsertedCount == 0 {
-		log.Printf("updateUnbondTransfers.writeValidatorModel: No data inserted or modified")
-	}
-
-	return nil
+	return updateFlows(ctx, c.collection.address_bond_sender_index, c.collection.address_bond_receiver_index, flows)
 }
 
-func (c *DbClient) updateWithdrawSender(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
-
-	if len(m.withdrawSender) == 0 {
-		return nil
-	}
-
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.withdrawSender {
-		for validator, withdrawSender := range record {
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "sender_amount", Value: withdrawSender.total})
-
-			for targetAddress, amount := range withdrawSender.addresses {
-				key := fmt.Sprintf("sender_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: validator},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
-	}
-
-	result, err := c.collection.address_withdraw_sender_index.BulkWrite(c.ctx, writeModel)
-	if err != nil {
-		return err
-	}
-
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateWithdrawSender: No data inserted or modified")
-	}
-
-	return nil
+// updateUnbondTransfers records the unbonds, and marks the validators as
+// unbonded in validator_stake
+func (c *DbClient) updateUnbondTransfers(ctx context.Context, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorUnbonds()
+	writes := make([]mongo.WriteModel, 0, len(rows))
+	validatorWrites := make([]mongo.WriteModel, 0, len(rows))
+
+	for _, row := range rows {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{
+				{Key: "time_index", Value: row.TimeIndex},
+				{Key: "address", Value: row.Address},
+			}).
+			SetReplacement(bson.D{
+				{Key: "time_index", Value: row.TimeIndex},
+				{Key: "address", Value: row.Address},
+				{Key: "height", Value: row.Height},
+				{Key: "hash", Value: row.Hash},
+				{Key: "time", Value: row.Time},
+			}).
+			SetUpsert(true))
+
+		validatorWrites = append(validatorWrites, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "address", Value: row.Address}}).
+			SetUpdate(bson.D{{Key: "$set", Value: bson.D{
+				{Key: "unbond_time_index", Value: row.TimeIndex},
+				{Key: "unbond_height", Value: row.Height},
+				{Key: "unbond_hash", Value: row.Hash},
+				{Key: "unbond_time", Value: row.Time},
+			}}}).
+			SetUpsert(true))
+	}
+
+	if err := bulkWrite(ctx, c.collection.address_unbond_index, writes); err != nil {
+		return err
+	}
+
+	return bulkWrite(ctx, c.collection.validator_stake, validatorWrites)
 }
 
-func (c *DbClient) updateWithdrawReceiver(commitContext CommitContext) error {
-	m := commitContext.GetTxMerger()
+func (c *DbClient) updateWithdraws(ctx context.Context, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().WithdrawTimeIndexes()
+	flows := make([]addressFlow, 0, len(rows))
 
-	if len(m.withdrawReceiver) == 0 {
-		return nil
+	for _, row := range rows {
+		flows = append(flows, addressFlow{timeIndex: row.TimeIndex, from: row.AddressFrom, to: row.AddressTo, amount: row.Amount})
 	}
 
-	writeModel := make([]mongo.WriteModel, 0)
-
-	for timeIndex, record := range m.withdrawReceiver {
-		for account, withdrawReceiver := range record {
-			incFields := bson.D{}
-
-			incFields = append(incFields, bson.E{Key: "receiver_amount", Value: withdrawReceiver.total})
-
-			for targetAddress, amount := range withdrawReceiver.addresses {
-				key := fmt.Sprintf("receiver_map.%s", targetAddress)
-				incFields = append(incFields, bson.E{Key: key, Value: amount})
-			}
-
-			update := mongo.NewUpdateOneModel().
-				SetFilter(bson.D{
-					{Key: "time_index", Value: timeIndex},
-					{Key: "address", Value: account},
-				}).
-				SetUpdate(bson.D{
-					{Key: "$inc", Value: incFields},
-				}).
-				SetUpsert(true)
-
-			writeModel = append(writeModel, update)
-		}
-	}
+	return updateFlows(ctx, c.collection.address_withdraw_sender_index, c.collection.address_withdraw_receiver_index, flows)
+}
 
-	result, err := c.collection.address_withdraw_receiver_index.BulkWrite(c.ctx, writeModel)
+// findAddressIndex returns the documents of an address since a time index
+func findAddressIndex(ctx context.Context, collection *mongo.Collection, address string, since int64) ([]*data.AddressIndexData, error) {
+	cursor, err := collection.Find(ctx, bson.D{
+		{Key: "address", Value: address},
+		{Key: "time_index", Value: bson.D{{Key: "$gte", Value: since}}},
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer cursor.Close(ctx)
 
-	if result.InsertedCount == 0 && result.ModifiedCount == 0 && result.UpsertedCount == 0 {
-		log.Printf("updateWithdrawReceiver: No data inserted or modified")
+	var rets []*data.AddressIndexData
+	if err := cursor.All(ctx, &rets); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return rets, nil
 }
@@ -2,29 +2,30 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sync"
+	"time"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/data"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-const (
-	TreasuryAddress = "000000000000000000000000000000000000000000"
-)
-
+// DbClient reads and writes the collections of the mongo store
 type DbClient struct {
-	ctx context.Context
-	//dbName     string
-	//client     *mongo.Client
 	collection *DbCollection
 	database   *mongo.Database
+
+	// whether the server runs transactions, known after the first commit.
+	// Commits run one after another.
+	transactionsChecked bool
+	transactions        bool
 }
 
 type DbCollection struct {
-	//addresses                       *mongo.Collection
 	global_state_index              *mongo.Collection
 	address_transfer_sender_index   *mongo.Collection
 	address_transfer_receiver_index *mongo.Collection
@@ -39,221 +40,218 @@ type DbCollection struct {
 	account_balance_index *mongo.Collection
 	validator_stake       *mongo.Collection
 
-	block *mongo.Collection
-	//tx                              *mongo.Collection
+	block      *mongo.Collection
+	checkpoint *mongo.Collection
 }
 
 func NewDBClient() *DbClient {
-	ctx := context.Background()
-
-	db := &DbClient{
-		ctx:        ctx,
+	return &DbClient{
 		collection: &DbCollection{},
 	}
-
-	return db
 }
 
-func (c *DbClient) Connect(database *mongo.Database) error {
+// Connect binds the collections of database, their indexes are created by
+// the driver from Indexes
+func (c *DbClient) Connect(database *mongo.Database) {
 	c.database = database
 
-	/*client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	c.collection.global_state_index = database.Collection("global_state_index")
+	c.collection.address_transfer_sender_index = database.Collection("address_transfer_sender_index")
+	c.collection.address_transfer_receiver_index = database.Collection("address_transfer_receiver_index")
+	c.collection.address_transfer_reward_index = database.Collection("address_transfer_reward_index")
+	c.collection.address_bond_sender_index = database.Collection("address_bond_sender_index")
+	c.collection.address_bond_receiver_index = database.Collection("address_bond_receiver_index")
+	c.collection.address_unbond_index = database.Collection("address_unbond_index")
+	c.collection.address_withdraw_sender_index = database.Collection("address_withdraw_sender_index")
+	c.collection.address_withdraw_receiver_index = database.Collection("address_withdraw_receiver_index")
+
+	c.collection.account_balance = database.Collection("account_balance")
+	c.collection.account_balance_index = database.Collection("account_balance_index")
+	c.collection.validator_stake = database.Collection("validator_stake")
+
+	c.collection.block = database.Collection("block")
+	c.collection.checkpoint = database.Collection("checkpoint")
+}
 
-	if err != nil {
-		return err
-	}*/
+func uniqueIndex(keys ...string) mongo.IndexModel {
+	index := plainIndex(keys...)
+	index.Options = options.Index().SetUnique(true)
+	return index
+}
 
-	//c.collection.addresses = client.Database(dbName).Collection("addresses")
+func plainIndex(keys ...string) mongo.IndexModel {
+	d := bson.D{}
+	for _, key := range keys {
+		d = append(d, bson.E{Key: key, Value: 1})
+	}
 
-	c.collection.block = c.database.Collection("block")
-	//c.collection.tx = client.Database(dbName).Collection("tx")
+	return mongo.IndexModel{Keys: d}
+}
 
-	/*if err := data.CreateAddressDataIndex(c.collection.addresses); err != nil {
-		return err
-	}*/
+// Indexes lists the indexes of the collections, the address index ones are
+// not unique as in the layout written before the postgres store
+func (c *DbClient) Indexes() map[*mongo.Collection][]mongo.IndexModel {
+	indexes := map[*mongo.Collection][]mongo.IndexModel{
+		c.collection.global_state_index:    {uniqueIndex("time_index")},
+		c.collection.block:                 {uniqueIndex("height")},
+		c.collection.account_balance:       {plainIndex("address")},
+		c.collection.account_balance_index: {plainIndex("time_index", "address")},
+		c.collection.validator_stake:       {plainIndex("address")},
+		c.collection.checkpoint:            {uniqueIndex("name")},
+	}
 
-	if err := c.createAddressIndex(); err != nil {
-		return err
+	for _, collection := range c.addressIndexCollections() {
+		indexes[collection] = []mongo.IndexModel{plainIndex("time_index", "address")}
 	}
 
-	if err := data.CreateBlockDataIndex(c.collection.block); err != nil {
-		return err
+	return indexes
+}
+
+func (c *DbClient) addressIndexCollections() []*mongo.Collection {
+	return []*mongo.Collection{
+		c.collection.address_transfer_sender_index,
+		c.collection.address_transfer_receiver_index,
+		c.collection.address_transfer_reward_index,
+		c.collection.address_bond_sender_index,
+		c.collection.address_bond_receiver_index,
+		c.collection.address_unbond_index,
+		c.collection.address_withdraw_sender_index,
+		c.collection.address_withdraw_receiver_index,
 	}
+}
 
-	if err := c.createAddressStateIndex(); err != nil {
-		return err
+type mongoCommitFunc struct {
+	name string
+	fn   func(ctx context.Context, commitContext PgCommitContext) error
+}
+
+// supportsTransactions tells whether the server runs transactions, which
+// replica set members and mongos do and a standalone server does not
+func (c *DbClient) supportsTransactions(ctx context.Context) (bool, error) {
+	if c.transactionsChecked {
+		return c.transactions, nil
 	}
 
-	if err := c.createGlobalStateIndex(); err != nil {
-		return err
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
 	}
 
-	/*if err := data.CreateTxDataIndex(c.collection.tx); err != nil {
-		return err
-	}*/
+	if err := c.database.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, err
+	}
+
+	c.transactions = hello.SetName != "" || hello.Msg == "isdbgrid"
+	c.transactionsChecked = true
 
-	return nil
+	if !c.transactions {
+		log.Warnf("mongo %s is a standalone server, periods are committed without transaction", c.database.Name())
+	}
+
+	return c.transactions, nil
 }
 
-/*
-func (c *dbClient) insertBlock(block *pactus.GetBlockResponse) error {
-	// Start a session
-	session, err := c.client.StartSession()
+// Commit writes a period together with the chainscan checkpoint, in a single
+// transaction as the postgres store does when the server runs transactions.
+// On a standalone server a failed commit may leave part of a period written.
+func (c *DbClient) Commit(ctx context.Context, commitContext PgCommitContext) error {
+	transactions, err := c.supportsTransactions(ctx)
 	if err != nil {
 		return err
 	}
-	defer session.EndSession(c.ctx)
-
-	// Start a transaction
-	_, err = session.WithTransaction(c.ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
-		// Insert block data
-		blockData := &data.BlockData{
-			Height: block.Height,
-		}
-
-		_, err := c.collection.block.InsertOne(sessCtx, blockData)
-		if err != nil {
-			return nil, err
-		}
 
-		// Prepare transaction data for bulk insert
-		var txDocs []interface{}
-		for _, tx := range block.Txs {
-			newTxData := &data.TransactionData{
-				Height: block.Height,
-				TxId:   tx.Id,
-				Value:  tx.Value,
-				Fee:    tx.Fee,
-				Memo:   tx.Memo,
-			}
-
-			switch tx.PayloadType {
-			case pactus.PayloadType_UNKNOWN:
-			case pactus.PayloadType_TRANSFER_PAYLOAD:
-				newTxData.Transfer = &data.TransferPayloadData{
-					Sender: tx.GetTransfer().Sender,
-					Amount: tx.GetTransfer().Amount,
-				}
-				sender := tx.GetTransfer().Sender
-
-				if sender != TreasuryAddress {
-					r := tx.GetTransfer().Receiver
-					log.Print(r)
-				}
-			case pactus.PayloadType_BOND_PAYLOAD:
-			case pactus.PayloadType_SORTITION_PAYLOAD:
-			case pactus.PayloadType_UNBOND_PAYLOAD:
-			case pactus.PayloadType_WITHDRAW_PAYLOAD:
-			}
-
-			txDocs = append(txDocs, newTxData)
-		}
-
-		//pactus.AddressType.
+	if !transactions {
+		return c.commitPeriod(ctx, commitContext)
+	}
 
-		// Bulk insert all transaction data
-		if len(txDocs) > 0 {
-			_, err := c.collection.tx.InsertMany(sessCtx, txDocs)
-			if err != nil {
-				return nil, err
-			}
-		}
+	session, err := c.database.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
 
-		return nil, nil
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, c.commitPeriod(sc, commitContext)
 	})
 
 	return err
-}*/
-
-type CommitContext interface {
-	GetTxMerger() *TxMerger
-	GetHeight() uint32
-	GetTimeIndex() uint32
-	GetGlobalState() *data.GlobalStateData
 }
 
-func (c *DbClient) Commit(commitContext CommitContext) error {
-	updateFuncs := []struct {
-		name string
-		fn   func(commitContext CommitContext) error
-	}{
+func (c *DbClient) commitPeriod(ctx context.Context, commitContext PgCommitContext) error {
+	updateFuncs := []mongoCommitFunc{
 		{"insertBlockData", c.insertBlockData},
-		{"InsertGlobalState", c.InsertGlobalState},
-		{"updateSenderTransfers", c.updateSenderTransfers},
-		{"updateReceiverTransfers", c.updateReceiverTransfers},
+		{"InsertGlobalState", c.insertGlobalState},
+		{"updateTransfers", c.updateTransfers},
 		{"updateRewardTransfers", c.updateRewardTransfers},
-		{"updateSenderBond", c.updateSenderBond},
-		{"updateReceiverBond", c.updateReceiverBond},
+		{"updateBonds", c.updateBonds},
 		{"updateUnbondTransfers", c.updateUnbondTransfers},
-		{"updateWithdrawSender", c.updateWithdrawSender},
-		{"updateWithdrawReceiver", c.updateWithdrawReceiver},
+		{"updateWithdraws", c.updateWithdraws},
 		{"updateAccountBalance", c.updateAccountBalance},
 		{"updateAccountBalanceIndex", c.updateAccountBalanceIndex},
 		{"updateValidatorStake", c.updateValidatorStake},
 	}
 
-	errChan := make(chan error, len(updateFuncs))
-	var wg sync.WaitGroup
+	checkpoint, err := c.GetCheckpoint(ctx, model.CheckpointChainscan)
+	if err != nil {
+		return err
+	}
 
-	for _, uf := range updateFuncs {
-		wg.Add(1)
-		go func(name string, fn func(CommitContext) error) {
-			defer wg.Done()
-			if err := fn(commitContext); err != nil {
-				errChan <- fmt.Errorf("%s error: %w", name, err)
-			}
-		}(uf.name, uf.fn)
+	var committed bool
+	if checkpoint != nil {
+		committed, err = periodCommitted(checkpoint.ToModel(), commitContext)
 	}
 
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
+	if err != nil || committed {
+		return err
+	}
 
-	for err := range errChan {
-		if err != nil {
-			return err
+	// operations of a transaction cannot run concurrently
+	for _, uf := range updateFuncs {
+		if err := uf.fn(ctx, commitContext); err != nil {
+			return fmt.Errorf("%s error: %w", uf.name, err)
 		}
 	}
 
-	return nil
-}
+	_, err = c.collection.checkpoint.UpdateOne(ctx,
+		bson.D{{Key: "name", Value: model.CheckpointChainscan}},
+		bson.D{{Key: "$set", Value: &data.CheckpointData{
+			Name:      model.CheckpointChainscan,
+			Height:    commitContext.GetHeight() - 1,
+			TimeIndex: commitContext.GetTimeIndex(),
+			UpdatedAt: time.Now().Unix(),
+		}}},
+		options.Update().SetUpsert(true))
 
-func (c *DbClient) CleanupBlockCollection() error {
-	opts := options.FindOne().SetSort(bson.D{{Key: "height", Value: -1}})
-	var latestBlock data.BlockData
-	err := c.collection.block.FindOne(context.Background(), bson.D{}, opts).Decode(&latestBlock)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil
-		}
-		return fmt.Errorf("get latest block failed: %v", err)
-	}
+	return err
+}
 
-	filter := bson.D{{Key: "height", Value: bson.D{{Key: "$lt", Value: latestBlock.Height}}}}
-	_, err = c.collection.block.DeleteMany(context.Background(), filter)
-	if err != nil {
-		return fmt.Errorf("delete block failed: %v", err)
+// bulkWrite runs the writes of a commit, unordered since each targets its
+// own document
+func bulkWrite(ctx context.Context, collection *mongo.Collection, writes []mongo.WriteModel) error {
+	if len(writes) == 0 {
+		return nil
 	}
 
-	return nil
+	_, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
 }
 
-func (c *DbClient) insertBlockData(commitContext CommitContext) error {
-	blockData := &data.BlockData{Height: commitContext.GetHeight(), TimeIndex: commitContext.GetTimeIndex()}
-	if _, err := c.collection.block.InsertOne(c.ctx, blockData); err != nil {
-		return err
-	}
-	return nil
+func (c *DbClient) insertBlockData(ctx context.Context, commitContext PgCommitContext) error {
+	_, err := c.collection.block.UpdateOne(ctx,
+		bson.D{{Key: "time_index", Value: commitContext.GetTimeIndex()}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "height", Value: commitContext.GetHeight()}}}},
+		options.Update().SetUpsert(true))
+
+	return err
 }
 
-func (c *DbClient) GetTopBlock() (*data.BlockData, error) {
+func (c *DbClient) GetTopBlock(ctx context.Context) (*data.BlockData, error) {
 	opts := options.FindOne().SetSort(bson.D{{Key: "height", Value: -1}})
 
 	var result data.BlockData
-	err := c.collection.block.FindOne(c.ctx, bson.D{}, opts).Decode(&result)
+	err := c.collection.block.FindOne(ctx, bson.D{}, opts).Decode(&result)
 
-	if err == mongo.ErrNoDocuments {
+	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, nil
 	}
 
@@ -264,13 +262,13 @@ func (c *DbClient) GetTopBlock() (*data.BlockData, error) {
 	return &result, nil
 }
 
-func (c *DbClient) GetTopGlobalState() (*data.GlobalState, error) {
+func (c *DbClient) GetTopGlobalState(ctx context.Context) (*data.GlobalStateData, error) {
 	opts := options.FindOne().SetSort(bson.D{{Key: "time_index", Value: -1}})
 
-	result := data.NewGlobalStateData()
-	err := c.collection.global_state_index.FindOne(c.ctx, bson.D{}, opts).Decode(result)
+	var result data.GlobalStateData
+	err := c.collection.global_state_index.FindOne(ctx, bson.D{}, opts).Decode(&result)
 
-	if err == mongo.ErrNoDocuments {
+	if errors.Is(err, mongo.ErrNoDocuments) {
 		return nil, nil
 	}
 
@@ -278,13 +276,71 @@ func (c *DbClient) GetTopGlobalState() (*data.GlobalState, error) {
 		return nil, err
 	}
 
-	return result, nil
+	return &result, nil
 }
 
-func (c *DbClient) InsertGlobalState(commitContext CommitContext) error {
-	_, err := c.collection.global_state_index.InsertOne(c.ctx, commitContext.GetGlobalState())
+// GetGlobalStates returns the count latest global states, newest first, or
+// all of them when count is not positive
+func (c *DbClient) GetGlobalStates(ctx context.Context, count int64) ([]*data.GlobalStateData, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "time_index", Value: -1}})
+
+	if count > 0 {
+		opts = opts.SetLimit(count)
+	}
+
+	cursor, err := c.collection.global_state_index.Find(ctx, bson.D{}, opts)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rets []*data.GlobalStateData
+	if err := cursor.All(ctx, &rets); err != nil {
+		return nil, err
 	}
-	return nil
+
+	return rets, nil
+}
+
+func (c *DbClient) InsertGlobalState(ctx context.Context, state *model.GlobalState) error {
+	_, err := c.collection.global_state_index.ReplaceOne(ctx,
+		bson.D{{Key: "time_index", Value: state.TimeIndex}},
+		data.NewGlobalStateData(state),
+		options.Replace().SetUpsert(true))
+
+	return err
+}
+
+func (c *DbClient) insertGlobalState(ctx context.Context, commitContext PgCommitContext) error {
+	return c.InsertGlobalState(ctx, commitContext.GetGlobalState())
+}
+
+func (c *DbClient) GetCheckpoint(ctx context.Context, name string) (*data.CheckpointData, error) {
+	var result data.CheckpointData
+	err := c.collection.checkpoint.FindOne(ctx, bson.D{{Key: "name", Value: name}}).Decode(&result)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *DbClient) GetValidatorStake(ctx context.Context, address string) (*data.ValidatorStakeData, error) {
+	var result data.ValidatorStakeData
+	err := c.collection.validator_stake.FindOne(ctx, bson.D{{Key: "address", Value: address}}).Decode(&result)
+
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
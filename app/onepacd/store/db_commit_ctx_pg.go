@@ -5,6 +5,7 @@ import (
 )
 
 type PgDBCommit struct {
+	startHeight     int64
 	height          int64
 	lastBlockHeight int64
 	timeIndex       int64
@@ -12,8 +13,9 @@ type PgDBCommit struct {
 	globalState     *model.GlobalState
 }
 
-func NewPgDBCommitContext(height int64, lastBlockHeight int64, timeIndex int64, txMerger *model.TxMerger, globalState *model.GlobalState) *PgDBCommit {
+func NewPgDBCommitContext(startHeight int64, height int64, lastBlockHeight int64, timeIndex int64, txMerger *model.TxMerger, globalState *model.GlobalState) *PgDBCommit {
 	p := &PgDBCommit{
+		startHeight:     startHeight,
 		height:          height,
 		lastBlockHeight: lastBlockHeight,
 		timeIndex:       timeIndex,
@@ -28,6 +30,10 @@ func (c *PgDBCommit) GetTxMerger() *model.TxMerger {
 	return c.txMerger
 }
 
+func (c *PgDBCommit) GetStartHeight() int64 {
+	return c.startHeight
+}
+
 func (c *PgDBCommit) GetHeight() int64 {
 	return c.height
 }
@@ -2,34 +2,72 @@ package store
 
 import (
 	"context"
+	"errors"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
 	"github.com/1pactus/1pactus-react/store/storedriver"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 )
 
-/*
-
-type IMongo interface {
-	storedriver.IMongoStore
-
-	GetDBAdapter() *DbClient
-
-	GetNetworkGlobalStats(count int64) ([]data.GlobalStateData, error)
-	GetUnbond(days int) (map[int64]int64, error)
-}*/
-
-type IPostgres interface {
-	storedriver.IPostgresGormStore
+// ErrUnsupported is returned by the queries a store backend cannot answer
+var ErrUnsupported = errors.New("not supported by the store backend")
 
+// IStore is what chainscan and the web api use of a network store, whatever
+// its backend
+type IStore interface {
 	GetTopGlobalState() (*model.GlobalState, error)
 	InsertGlobalState(state *model.GlobalState) error
 	GetNetworkGlobalStats(count int64) ([]model.GlobalState, error)
 
 	GetTopBlock() (*model.Block, error)
+	GetCheckpoint(name string) (*model.Checkpoint, error)
+	// GetValidatorStake returns the committed stake of a validator, 0 when it
+	// is unknown
+	GetValidatorStake(address string) (int64, error)
+	GetBlockTiming(days int64) ([]*model.BlockTimingTimeIndex, error)
+
+	GetAddressFlows(address string, days int64) ([]*model.AddressFlow, error)
+	GetAddressCounterparties(address string, days int64, limit int) ([]*model.AddressCounterparty, error)
+
+	GetUnbondForecast(days int64) (*model.UnbondForecast, error)
+	GetStakingYield(days int64) (*model.StakingYield, error)
+
+	GetValidatorLeaderboard(days int64, limit int, orderBy string) ([]*model.ValidatorStat, error)
+	GetValidatorHistory(address string, days int64) ([]*model.ValidatorStat, error)
+	GetValidatorUptime(days int64, limit int, orderBy string) ([]*model.ValidatorUptime, error)
+	GetValidatorChurn(days int64) ([]*model.ValidatorChurnTimeIndex, error)
+	GetValidatorTimeline(address string) (*model.ValidatorLifecycle, []*model.ValidatorEvent, error)
+
+	GetRichList(limit int, excludeReserve bool) ([]*model.RichListEntry, error)
+	GetWealthDistribution(days int64, excludeReserve bool) ([]*model.WealthDistributionTimeIndex, error)
+
+	GetDistinctActive(from int64, to int64) (*model.DistinctActive, error)
+	GetAccountActivity(days int64) ([]*model.AccountActivityTimeIndex, error)
+	GetAccountRetention(weeks int64) ([]*model.AccountCohort, error)
+
+	GetIndexedTotals() (*model.IndexedTotals, error)
+	GetRandomAccountBalances(limit int) ([]*model.AccountBalance, error)
+	GetAccountBalances(addresses []string) ([]*model.AccountBalance, error)
+	GetRandomValidatorStates(limit int) ([]*model.ValidatorState, error)
+	InsertAuditRun(run *model.AuditRun) error
+	GetLatestAuditRun() (*model.AuditRun, error)
+
 	Commit(commitContext PgCommitContext) error
 }
 
+type IPostgres interface {
+	storedriver.IPostgresGormStore
+	IStore
+}
+
+// IMongo is the legacy backend kept on the mongo collections. It answers the
+// chain, global state and address flow queries, the others return
+// ErrUnsupported.
+type IMongo interface {
+	storedriver.IMongoStore
+	IStore
+}
+
 type IKafka interface {
 	storedriver.IKafkaStore
 
@@ -40,7 +78,7 @@ type IKafka interface {
 }
 
 var (
-	//Mongo    IMongo    = &mongoStore{}
-	Postgres IPostgres = &postgresStore{}
-	Kafka    IKafka    = &kafkaStore{}
+	_ IPostgres = &postgresStore{}
+	_ IMongo    = &mongoStore{}
+	_ IKafka    = &kafkaStore{}
 )
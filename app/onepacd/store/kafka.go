@@ -14,11 +14,12 @@ import (
 )
 
 const (
-	kafkaTopicBlocks = "onepacd-blocks"
+	KafkaDefaultTopicBlocks = "onepacd-blocks"
 )
 
 type kafkaStore struct {
 	storedriver.Kafka
+	topicBlocks  string
 	conf         *config.KafkaConfig
 	blocksReader *kafka.Reader
 	writer       *kafka.Writer
@@ -27,12 +28,12 @@ type kafkaStore struct {
 func (s *kafkaStore) Init(store storedriver.Kafka, conf *config.KafkaConfig) {
 	s.Kafka = store
 	s.conf = conf
-	s.blocksReader = store.GetReader(kafkaTopicBlocks)
+	s.blocksReader = store.GetReader(s.topicBlocks)
 	s.writer = store.GetWriter()
 }
 
 func (s *kafkaStore) Topics() []string {
-	return []string{kafkaTopicBlocks}
+	return []string{s.topicBlocks}
 }
 
 func (s *kafkaStore) SendMessage(topic, key string, value []byte) error {
@@ -59,7 +60,7 @@ func (s *kafkaStore) SendBlock(block *pactus.GetBlockResponse) error {
 	defer cancel()
 
 	message := kafka.Message{
-		Topic: kafkaTopicBlocks,
+		Topic: s.topicBlocks,
 		Key:   nil,
 		Value: data,
 		Time:  time.Now(),
@@ -69,7 +70,7 @@ func (s *kafkaStore) SendBlock(block *pactus.GetBlockResponse) error {
 }
 
 func (s *kafkaStore) ConsumeBlocks(ctx context.Context, groupID string, offset int64, blocksChan chan<- *pactus.GetBlockResponse) error {
-	reader := s.Kafka.GetReader(kafkaTopicBlocks, storedriver.NewReaderOptions().
+	reader := s.Kafka.GetReader(s.topicBlocks, storedriver.NewReaderOptions().
 		WithGroupID(groupID).
 		WithSeekOffset(offset))
 
@@ -104,7 +105,7 @@ func (s *kafkaStore) ConsumeBlocks(ctx context.Context, groupID string, offset i
 var ErrorKafkaTopicEmpty = fmt.Errorf("kafka topic is empty")
 
 func (s *kafkaStore) GetLastBlockHeight() (int64, error) {
-	partitionsLastMessage, err := s.Kafka.GetAllPartitionsLastMessage(kafkaTopicBlocks)
+	partitionsLastMessage, err := s.Kafka.GetAllPartitionsLastMessage(s.topicBlocks)
 
 	if err != nil {
 		return 0, fmt.Errorf("GetAllPartitionsLastMessage failed: %w", err)
@@ -134,7 +135,7 @@ func (s *kafkaStore) GetBlockHeightOffset(height int64) (int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.Kafka.GetTimeout())
 	defer cancel()
 
-	offset, err := s.Kafka.FindOffset(ctx, kafkaTopicBlocks, func(message kafka.Message) (int, error) {
+	offset, err := s.Kafka.FindOffset(ctx, s.topicBlocks, func(message kafka.Message) (int, error) {
 		var block pactus.GetBlockResponse
 		if err := proto.Unmarshal(message.Value, &block); err != nil {
 			return 0, fmt.Errorf("failed to unmarshal block: %w", err)
@@ -0,0 +1,29 @@
+DROP TABLE IF EXISTS "checkpoints";
+DROP TABLE IF EXISTS "block_round_time_indices";
+DROP TABLE IF EXISTS "block_timing_time_indices";
+DROP TABLE IF EXISTS "validator_churn_time_indices";
+DROP TABLE IF EXISTS "validator_lifecycles";
+DROP TABLE IF EXISTS "validator_events";
+DROP TABLE IF EXISTS "active_sketch_time_indices";
+DROP TABLE IF EXISTS "account_activity_time_indices";
+DROP TABLE IF EXISTS "account_active_time_indices";
+DROP TABLE IF EXISTS "account_activities";
+DROP TABLE IF EXISTS "wealth_bucket_time_indices";
+DROP TABLE IF EXISTS "wealth_distribution_time_indices";
+DROP TABLE IF EXISTS "audit_records";
+DROP TABLE IF EXISTS "audit_runs";
+DROP TABLE IF EXISTS "payload_stat_time_indices";
+DROP TABLE IF EXISTS "validator_reward_time_indices";
+DROP TABLE IF EXISTS "validator_stat_time_indices";
+DROP TABLE IF EXISTS "validator_unbonds";
+DROP TABLE IF EXISTS "tx_withdraw_time_indices";
+DROP TABLE IF EXISTS "tx_unbond_time_indices";
+DROP TABLE IF EXISTS "tx_bond_time_indices";
+DROP TABLE IF EXISTS "tx_reward_time_indices";
+DROP TABLE IF EXISTS "tx_transfer_time_indices";
+DROP TABLE IF EXISTS "validator_stake_time_indices";
+DROP TABLE IF EXISTS "validator_states";
+DROP TABLE IF EXISTS "account_balance_time_indices";
+DROP TABLE IF EXISTS "account_balances";
+DROP TABLE IF EXISTS "blocks";
+DROP TABLE IF EXISTS "global_states";
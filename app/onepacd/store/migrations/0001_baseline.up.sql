@@ -0,0 +1,306 @@
+-- schema of onepacd when versioned migrations were introduced. Tables and
+-- indexes use IF NOT EXISTS so databases set up by the AutoMigrate of the
+-- previous release adopt it unchanged.
+
+CREATE TABLE IF NOT EXISTS "global_states" (
+    "time_index" bigserial NOT NULL,
+    "stake" bigint NOT NULL,
+    "supply" bigint NOT NULL,
+    "circulating_supply" bigint NOT NULL,
+    "txs" bigint NOT NULL,
+    "blocks" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "reward" bigint NOT NULL DEFAULT 0,
+    "active_validator" bigint NOT NULL,
+    "active_account" bigint NOT NULL,
+    "sortitions" bigint NOT NULL DEFAULT 0,
+    "committee_size" bigint NOT NULL DEFAULT 0,
+    "committee_turnover" bigint NOT NULL DEFAULT 0,
+    "cert_signed" bigint NOT NULL DEFAULT 0,
+    "cert_missed" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("time_index")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_time_index" ON "global_states" ("time_index");
+
+CREATE TABLE IF NOT EXISTS "blocks" (
+    "time_index" bigserial NOT NULL,
+    "height" bigint NOT NULL,
+    PRIMARY KEY ("time_index")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_height" ON "blocks" ("height");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_time_index" ON "blocks" ("time_index");
+
+CREATE TABLE IF NOT EXISTS "account_balances" (
+    "address" text NOT NULL,
+    "balance" bigint NOT NULL,
+    PRIMARY KEY ("address")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_address" ON "account_balances" ("address");
+
+CREATE TABLE IF NOT EXISTS "account_balance_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "balance_change" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "validator_states" (
+    "address" text NOT NULL,
+    "stake" bigint NOT NULL,
+    "stake_max" bigint NOT NULL,
+    PRIMARY KEY ("address")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_address" ON "validator_states" ("address");
+
+CREATE TABLE IF NOT EXISTS "validator_stake_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "stake_change" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "tx_transfer_time_indices" (
+    "address_from" text NOT NULL,
+    "address_to" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    PRIMARY KEY ("address_from",
+    "address_to",
+    "time_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_tx_transfer_to" ON "tx_transfer_time_indices" ("address_to","time_index");
+
+CREATE TABLE IF NOT EXISTS "tx_reward_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "tx_bond_time_indices" (
+    "address_from" text NOT NULL,
+    "address_to" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    PRIMARY KEY ("address_from",
+    "address_to",
+    "time_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_tx_bond_to" ON "tx_bond_time_indices" ("address_to","time_index");
+
+CREATE TABLE IF NOT EXISTS "tx_unbond_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "time" bigint NOT NULL,
+    "hash" text NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "tx_withdraw_time_indices" (
+    "address_from" text NOT NULL,
+    "address_to" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    PRIMARY KEY ("address_from",
+    "address_to",
+    "time_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_tx_withdraw_to" ON "tx_withdraw_time_indices" ("address_to","time_index");
+
+CREATE TABLE IF NOT EXISTS "validator_unbonds" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "height" bigint NOT NULL,
+    "time" bigint NOT NULL,
+    "hash" text NOT NULL,
+    "stake" bigint NOT NULL,
+    "mature_height" bigint NOT NULL,
+    "mature_time_index" bigint NOT NULL,
+    "withdrawn" bigint NOT NULL,
+    "withdrawn_time_index" bigint NOT NULL,
+    PRIMARY KEY ("address")
+);
+CREATE INDEX IF NOT EXISTS "idx_validator_unbond_mature" ON "validator_unbonds" ("mature_time_index");
+
+CREATE TABLE IF NOT EXISTS "validator_stat_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "blocks_proposed" bigint NOT NULL,
+    "reward" bigint NOT NULL,
+    "sortitions" bigint NOT NULL DEFAULT 0,
+    "certs_signed" bigint NOT NULL DEFAULT 0,
+    "certs_missed" bigint NOT NULL DEFAULT 0,
+    "stake" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_validator_stat_time_index" ON "validator_stat_time_indices" ("time_index");
+
+CREATE TABLE IF NOT EXISTS "validator_reward_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "receiver" text NOT NULL,
+    "amount" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index",
+    "receiver")
+);
+
+CREATE TABLE IF NOT EXISTS "payload_stat_time_indices" (
+    "time_index" bigint NOT NULL,
+    "payload_type" integer NOT NULL,
+    "txs" bigint NOT NULL,
+    "volume" bigint NOT NULL,
+    "fee" bigint NOT NULL,
+    "fee_min" bigint NOT NULL,
+    "fee_median" bigint NOT NULL,
+    "fee_p90" bigint NOT NULL,
+    "fee_max" bigint NOT NULL,
+    PRIMARY KEY ("time_index",
+    "payload_type")
+);
+
+CREATE TABLE IF NOT EXISTS "audit_runs" (
+    "id" bigserial,
+    "time" bigint NOT NULL,
+    "indexed_height" bigint NOT NULL,
+    "node_height" bigint NOT NULL,
+    "checks" bigint NOT NULL,
+    "discrepancies" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_run_time" ON "audit_runs" ("time");
+
+CREATE TABLE IF NOT EXISTS "audit_records" (
+    "id" bigserial,
+    "run_id" bigint NOT NULL,
+    "check" text NOT NULL,
+    "subject" text NOT NULL,
+    "indexed" bigint NOT NULL,
+    "node" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_record_run" ON "audit_records" ("run_id");
+
+CREATE TABLE IF NOT EXISTS "wealth_distribution_time_indices" (
+    "time_index" bigint NOT NULL,
+    "exclude_reserve" boolean NOT NULL,
+    "accounts" bigint NOT NULL,
+    "total" bigint NOT NULL,
+    "gini" decimal NOT NULL,
+    "top10" bigint NOT NULL,
+    "top100" bigint NOT NULL,
+    "top1000" bigint NOT NULL,
+    PRIMARY KEY ("time_index",
+    "exclude_reserve")
+);
+
+CREATE TABLE IF NOT EXISTS "wealth_bucket_time_indices" (
+    "time_index" bigint NOT NULL,
+    "exclude_reserve" boolean NOT NULL,
+    "exponent" integer NOT NULL,
+    "accounts" bigint NOT NULL,
+    "balance" bigint NOT NULL,
+    PRIMARY KEY ("time_index",
+    "exclude_reserve",
+    "exponent")
+);
+
+CREATE TABLE IF NOT EXISTS "account_activities" (
+    "address" text NOT NULL,
+    "first_time_index" bigint NOT NULL,
+    "last_time_index" bigint NOT NULL,
+    "active_days" bigint NOT NULL,
+    PRIMARY KEY ("address")
+);
+CREATE INDEX IF NOT EXISTS "idx_account_activity_first" ON "account_activities" ("first_time_index");
+
+CREATE TABLE IF NOT EXISTS "account_active_time_indices" (
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    PRIMARY KEY ("address",
+    "time_index")
+);
+CREATE INDEX IF NOT EXISTS "idx_account_active_time_index" ON "account_active_time_indices" ("time_index");
+
+CREATE TABLE IF NOT EXISTS "account_activity_time_indices" (
+    "time_index" bigserial NOT NULL,
+    "active" bigint NOT NULL,
+    "new" bigint NOT NULL,
+    "returning" bigint NOT NULL,
+    "reactivated" bigint NOT NULL,
+    PRIMARY KEY ("time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "active_sketch_time_indices" (
+    "time_index" bigserial NOT NULL,
+    "accounts" bytea NOT NULL,
+    "validators" bytea NOT NULL,
+    PRIMARY KEY ("time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "validator_events" (
+    "hash" text NOT NULL,
+    "address" text NOT NULL,
+    "time_index" bigint NOT NULL,
+    "height" bigint NOT NULL,
+    "time" bigint NOT NULL,
+    "type" text NOT NULL,
+    "amount" bigint NOT NULL,
+    "account" text NOT NULL,
+    PRIMARY KEY ("hash")
+);
+CREATE INDEX IF NOT EXISTS "idx_validator_event_address" ON "validator_events" ("address");
+
+CREATE TABLE IF NOT EXISTS "validator_lifecycles" (
+    "address" text NOT NULL,
+    "bond_time_index" bigint NOT NULL,
+    "bond_height" bigint NOT NULL,
+    "unbond_time_index" bigint NOT NULL,
+    "withdraw_time_index" bigint NOT NULL,
+    PRIMARY KEY ("address")
+);
+CREATE INDEX IF NOT EXISTS "idx_validator_lifecycle_unbond" ON "validator_lifecycles" ("unbond_time_index");
+
+CREATE TABLE IF NOT EXISTS "validator_churn_time_indices" (
+    "time_index" bigserial NOT NULL,
+    "new" bigint NOT NULL,
+    "exited" bigint NOT NULL,
+    "withdrawn" bigint NOT NULL,
+    "live" bigint NOT NULL,
+    PRIMARY KEY ("time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "block_timing_time_indices" (
+    "time_index" bigserial NOT NULL,
+    "intervals" bigint NOT NULL,
+    "interval_mean" decimal NOT NULL,
+    "interval_p50" bigint NOT NULL,
+    "interval_p95" bigint NOT NULL,
+    "interval_max" bigint NOT NULL,
+    "slow_blocks" bigint NOT NULL,
+    "slow_threshold" bigint NOT NULL,
+    "round_changes" bigint NOT NULL,
+    "max_round" integer NOT NULL,
+    PRIMARY KEY ("time_index")
+);
+
+CREATE TABLE IF NOT EXISTS "block_round_time_indices" (
+    "time_index" bigint NOT NULL,
+    "round" integer NOT NULL,
+    "blocks" bigint NOT NULL,
+    PRIMARY KEY ("time_index",
+    "round")
+);
+
+CREATE TABLE IF NOT EXISTS "checkpoints" (
+    "name" text NOT NULL,
+    "height" bigint NOT NULL,
+    "time_index" bigint NOT NULL,
+    "updated_at" bigint NOT NULL,
+    PRIMARY KEY ("name")
+);
@@ -0,0 +1,24 @@
+-- copies the partitioned history back into plain tables, the rows of detached
+-- partitions are not restored
+DO $$
+DECLARE
+    t text;
+    pkey_def text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['account_balance_time_indices', 'tx_transfer_time_indices', 'tx_reward_time_indices',
+        'tx_bond_time_indices', 'tx_unbond_time_indices', 'tx_withdraw_time_indices'] LOOP
+        SELECT pg_get_constraintdef(oid) INTO pkey_def
+            FROM pg_constraint WHERE conrelid = t::regclass AND contype = 'p';
+
+        EXECUTE format('CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS)', t || '_unpartitioned', t);
+        EXECUTE format('INSERT INTO %I SELECT * FROM %I', t || '_unpartitioned', t);
+        EXECUTE format('DROP TABLE %I', t);
+        EXECUTE format('ALTER TABLE %I RENAME TO %I', t || '_unpartitioned', t);
+        EXECUTE format('ALTER TABLE %I ADD %s', t, pkey_def);
+    END LOOP;
+END
+$$;
+
+CREATE INDEX IF NOT EXISTS "idx_tx_transfer_to" ON "tx_transfer_time_indices" ("address_to","time_index");
+CREATE INDEX IF NOT EXISTS "idx_tx_bond_to" ON "tx_bond_time_indices" ("address_to","time_index");
+CREATE INDEX IF NOT EXISTS "idx_tx_withdraw_to" ON "tx_withdraw_time_indices" ("address_to","time_index");
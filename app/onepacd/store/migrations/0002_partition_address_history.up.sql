@@ -0,0 +1,48 @@
+-- range partitions the per address history by month of time_index. The rows
+-- stay in place: each table becomes the <table>_legacy partition, covering
+-- everything before the month after its newest row, of a new partitioned
+-- table, and the driver creates the monthly partitions from there on.
+DROP INDEX IF EXISTS "idx_account_balance_time_index_brin";
+DROP INDEX IF EXISTS "idx_tx_transfer_time_index_brin";
+
+DO $$
+DECLARE
+    t text;
+    pkey text;
+    pkey_def text;
+    idx text;
+    top bigint;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['account_balance_time_indices', 'tx_transfer_time_indices', 'tx_reward_time_indices',
+        'tx_bond_time_indices', 'tx_unbond_time_indices', 'tx_withdraw_time_indices'] LOOP
+        SELECT conname, pg_get_constraintdef(oid) INTO pkey, pkey_def
+            FROM pg_constraint WHERE conrelid = t::regclass AND contype = 'p';
+
+        -- the index names of the new table are taken by the old one
+        EXECUTE format('ALTER TABLE %I RENAME TO %I', t, t || '_legacy');
+        EXECUTE format('ALTER TABLE %I RENAME CONSTRAINT %I TO %I', t || '_legacy', pkey, t || '_legacy_pkey');
+
+        FOR idx IN SELECT i.relname FROM pg_index ix JOIN pg_class i ON i.oid = ix.indexrelid
+            WHERE ix.indrelid = (t || '_legacy')::regclass AND NOT ix.indisprimary LOOP
+            EXECUTE format('ALTER INDEX %I RENAME TO %I', idx, idx || '_legacy');
+        END LOOP;
+
+        EXECUTE format('CREATE TABLE %I (LIKE %I INCLUDING DEFAULTS) PARTITION BY RANGE (time_index)', t, t || '_legacy');
+        EXECUTE format('ALTER TABLE %I ADD %s', t, pkey_def);
+
+        EXECUTE format('SELECT max(time_index) FROM %I', t || '_legacy') INTO top;
+
+        IF top IS NULL THEN
+            EXECUTE format('DROP TABLE %I', t || '_legacy');
+        ELSE
+            EXECUTE format('ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (MINVALUE) TO (%s)', t, t || '_legacy',
+                extract(epoch FROM date_trunc('month', to_timestamp(top) AT TIME ZONE 'UTC') + interval '1 month')::bigint);
+        END IF;
+    END LOOP;
+END
+$$;
+
+-- matching indexes of the legacy partitions are attached rather than rebuilt
+CREATE INDEX IF NOT EXISTS "idx_tx_transfer_to" ON "tx_transfer_time_indices" ("address_to","time_index");
+CREATE INDEX IF NOT EXISTS "idx_tx_bond_to" ON "tx_bond_time_indices" ("address_to","time_index");
+CREATE INDEX IF NOT EXISTS "idx_tx_withdraw_to" ON "tx_withdraw_time_indices" ("address_to","time_index");
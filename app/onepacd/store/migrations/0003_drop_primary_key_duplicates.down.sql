@@ -0,0 +1,2 @@
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_time_index" ON "global_states" ("time_index");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_address" ON "account_balances" ("address");
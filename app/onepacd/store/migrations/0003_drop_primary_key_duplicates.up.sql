@@ -0,0 +1,6 @@
+-- drops the unique indexes duplicating a primary key. Their names were shared
+-- by two tables, so only those of global_states and account_balances were
+-- ever created, and a database with one namespace for all indexes such as
+-- sqlite cannot create them at all.
+DROP INDEX IF EXISTS "idx_time_index";
+DROP INDEX IF EXISTS "idx_address";
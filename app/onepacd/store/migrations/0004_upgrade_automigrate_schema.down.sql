@@ -0,0 +1 @@
+ALTER INDEX IF EXISTS "idx_block_height" RENAME TO "idx_height";
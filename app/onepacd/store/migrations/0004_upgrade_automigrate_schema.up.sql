@@ -0,0 +1,17 @@
+-- brings a database set up by the AutoMigrate of an older release, which 0001
+-- adopted without touching its tables, to the schema of the baseline.
+
+-- columns added after AutoMigrate first created their table
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "reward" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "sortitions" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "committee_size" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "committee_turnover" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "cert_signed" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "global_states" ADD COLUMN IF NOT EXISTS "cert_missed" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "validator_stat_time_indices" ADD COLUMN IF NOT EXISTS "sortitions" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "validator_stat_time_indices" ADD COLUMN IF NOT EXISTS "certs_signed" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "validator_stat_time_indices" ADD COLUMN IF NOT EXISTS "certs_missed" bigint NOT NULL DEFAULT 0;
+
+-- AutoMigrate named indexes after their column only, the one left after 0003
+-- is named after its table
+ALTER INDEX IF EXISTS "idx_height" RENAME TO "idx_block_height";
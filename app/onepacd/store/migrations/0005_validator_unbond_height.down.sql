@@ -0,0 +1,5 @@
+-- keeps the latest unbond of each validator
+DELETE FROM "validator_unbonds" u USING "validator_unbonds" later
+    WHERE later."address" = u."address" AND later."height" > u."height";
+ALTER TABLE "validator_unbonds" DROP CONSTRAINT IF EXISTS "validator_unbonds_pkey";
+ALTER TABLE "validator_unbonds" ADD PRIMARY KEY ("address");
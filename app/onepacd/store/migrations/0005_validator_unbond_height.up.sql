@@ -0,0 +1,3 @@
+-- a validator may unbond more than once, each unbond is kept apart
+ALTER TABLE "validator_unbonds" DROP CONSTRAINT IF EXISTS "validator_unbonds_pkey";
+ALTER TABLE "validator_unbonds" ADD PRIMARY KEY ("address", "height");
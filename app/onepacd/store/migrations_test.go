@@ -0,0 +1,157 @@
+package store
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// baselineSchema is what the AutoMigrate of the release before the versioned
+// migrations created. blocks has no idx_time_index, global_states took the name.
+var baselineSchema = []string{
+	`CREATE TABLE "global_states" ("time_index" bigserial NOT NULL, "stake" bigint NOT NULL, "supply" bigint NOT NULL, ` +
+		`"circulating_supply" bigint NOT NULL, "txs" bigint NOT NULL, "blocks" bigint NOT NULL, "fee" bigint NOT NULL, ` +
+		`"active_validator" bigint NOT NULL, "active_account" bigint NOT NULL, PRIMARY KEY ("time_index"))`,
+	`CREATE UNIQUE INDEX "idx_time_index" ON "global_states" ("time_index")`,
+	`CREATE TABLE "blocks" ("time_index" bigserial NOT NULL, "height" bigint NOT NULL, PRIMARY KEY ("time_index"))`,
+	`CREATE UNIQUE INDEX "idx_height" ON "blocks" ("height")`,
+	`INSERT INTO "global_states" VALUES (1700006400, 10, 20, 15, 3, 2, 1, 4, 5)`,
+}
+
+var (
+	createIndexRegexp = regexp.MustCompile(`CREATE (?:UNIQUE )?INDEX IF NOT EXISTS "(\w+)" ON "(\w+)"`)
+	dropIndexRegexp   = regexp.MustCompile(`DROP INDEX IF EXISTS "(\w+)"`)
+	renameIndexRegexp = regexp.MustCompile(`ALTER INDEX IF EXISTS "(\w+)" RENAME TO "(\w+)"`)
+)
+
+// TestMigrationIndexNames replays the index statements of the migrations in
+// order, one statement per line. An index name declared on two tables only exists on the first one,
+// the migrations must drop or rename it before they are done.
+func TestMigrationIndexNames(t *testing.T) {
+	migrations, err := storedriver.LoadMigrations((&postgresStore{}).Migrations())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := make(map[string]map[string]bool)
+
+	for _, migration := range migrations {
+		for _, statement := range strings.Split(migration.Up, "\n") {
+			if match := createIndexRegexp.FindStringSubmatch(statement); match != nil {
+				if tables[match[1]] == nil {
+					tables[match[1]] = make(map[string]bool)
+				}
+				tables[match[1]][match[2]] = true
+			} else if match := dropIndexRegexp.FindStringSubmatch(statement); match != nil {
+				delete(tables, match[1])
+			} else if match := renameIndexRegexp.FindStringSubmatch(statement); match != nil && tables[match[1]] != nil {
+				tables[match[2]] = tables[match[1]]
+				delete(tables, match[1])
+			}
+		}
+	}
+
+	for name, on := range tables {
+		if len(on) > 1 {
+			t.Errorf("index %s is declared on %d tables", name, len(on))
+		}
+	}
+
+	if tables["idx_block_height"] == nil {
+		t.Errorf("idx_height of blocks was not renamed")
+	}
+}
+
+// TestMigrateBaselineDatabase runs against the database of
+// ONEPACD_TEST_POSTGRES_DSN, in a schema it drops afterwards
+func TestMigrateBaselineDatabase(t *testing.T) {
+	dsn := os.Getenv("ONEPACD_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("ONEPACD_TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrations, err := storedriver.LoadMigrations((&postgresStore{}).Migrations())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// search_path is per connection
+	err = db.Connection(func(conn *gorm.DB) error {
+		for _, statement := range []string{
+			`DROP SCHEMA IF EXISTS "migrate_baseline_test" CASCADE`,
+			`CREATE SCHEMA "migrate_baseline_test"`,
+			`SET search_path TO "migrate_baseline_test"`,
+		} {
+			if err := conn.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		defer conn.Exec(`DROP SCHEMA IF EXISTS "migrate_baseline_test" CASCADE`)
+
+		for _, statement := range baselineSchema {
+			if err := conn.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+
+		migrator := storedriver.NewPostgresMigrator(conn, migrations, log.WithKv("module", "migrate"))
+		if _, err := migrator.Up(); err != nil {
+			return err
+		}
+
+		for _, m := range (&postgresStore{}).Models() {
+			stmt := &gorm.Statement{DB: conn}
+			if err := stmt.Parse(m); err != nil {
+				return err
+			}
+
+			for _, field := range stmt.Schema.Fields {
+				if field.DBName != "" && !conn.Migrator().HasColumn(m, field.DBName) {
+					t.Errorf("%s has no column %s", stmt.Schema.Table, field.DBName)
+				}
+			}
+
+			for _, index := range stmt.Schema.ParseIndexes() {
+				if !conn.Migrator().HasIndex(m, index.Name) {
+					t.Errorf("%s has no index %s", stmt.Schema.Table, index.Name)
+				}
+			}
+		}
+
+		var legacy int64
+		if err := conn.Raw(`SELECT COUNT(*) FROM pg_indexes WHERE schemaname = current_schema() AND indexname IN ('idx_time_index', 'idx_height')`).
+			Scan(&legacy).Error; err != nil {
+			return err
+		}
+
+		if legacy != 0 {
+			t.Errorf("%d indexes kept a name shared between tables", legacy)
+		}
+
+		var reward int64
+		if err := conn.Raw(`SELECT reward FROM global_states WHERE time_index = 1700006400`).Scan(&reward).Error; err != nil {
+			return err
+		}
+
+		if reward != 0 {
+			t.Errorf("reward of a baseline row = %d, want 0", reward)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
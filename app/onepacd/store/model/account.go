@@ -2,7 +2,7 @@ package model
 
 // record of account balance latest state
 type AccountBalance struct {
-	Address string `gorm:"primaryKey;uniqueIndex:idx_address;not null"`
+	Address string `gorm:"primaryKey;not null"`
 	Balance int64  `gorm:"not null"`
 }
 
@@ -15,7 +15,14 @@ type AccountBalanceTimeIndex struct {
 
 // record of validator latest state
 type ValidatorState struct {
-	Address  string `gorm:"primaryKey;uniqueIndex:idx_address;not null"`
+	Address  string `gorm:"primaryKey;not null"`
 	Stake    int64  `gorm:"not null"`
 	StakeMax int64  `gorm:"not null"`
 }
+
+// record of validator stake changes over timeindex
+type ValidatorStakeTimeIndex struct {
+	Address     string `gorm:"primaryKey;not null"`
+	TimeIndex   int64  `gorm:"primaryKey;not null"`
+	StakeChange int64  `gorm:"not null"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+
+const (
+	// an account inactive for this many days counts as reactivated when it is
+	// active again
+	AccountDormantDays = 30
+)
+
+// record of the first and last day an account was active
+type AccountActivity struct {
+	Address        string `gorm:"primaryKey;not null"`
+	FirstTimeIndex int64  `gorm:"index:idx_account_activity_first;not null"`
+	LastTimeIndex  int64  `gorm:"not null"`
+	ActiveDays     int64  `gorm:"not null"`
+}
+
+// record of an account being active over timeindex
+type AccountActiveTimeIndex struct {
+	Address   string `gorm:"primaryKey;not null"`
+	TimeIndex int64  `gorm:"primaryKey;index:idx_account_active_time_index;not null"`
+}
+
+// daily split of the active accounts by their history
+type AccountActivityTimeIndex struct {
+	TimeIndex int64 `gorm:"primaryKey;not null"`
+	Active    int64 `gorm:"not null"`
+	New       int64 `gorm:"not null"`
+	Returning int64 `gorm:"not null"`
+	// returning accounts that were dormant before
+	Reactivated int64 `gorm:"not null"`
+}
+
+func (a *AccountActivityTimeIndex) ToProto() *api.AccountActivityData {
+	return &api.AccountActivityData{
+		TimeIndex:   uint32(a.TimeIndex),
+		Active:      a.Active,
+		New:         a.New,
+		Returning:   a.Returning,
+		Reactivated: a.Reactivated,
+	}
+}
+
+// accounts first active in a week and how many of them are active in each
+// following week, starting with the cohort week itself
+type AccountCohort struct {
+	Week     int64
+	Size     int64
+	Retained []int64
+}
+
+func (c *AccountCohort) ToProto() *api.AccountCohortData {
+	return &api.AccountCohortData{
+		Week:     uint32(c.Week),
+		Size:     c.Size,
+		Retained: c.Retained,
+	}
+}
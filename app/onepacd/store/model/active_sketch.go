@@ -0,0 +1,96 @@
+package model
+
+import (
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+	"github.com/axiomhq/hyperloglog"
+)
+
+// hyperloglog sketches of the addresses active over timeindex, merging the
+// sketches of several days counts the distinct actives of the whole range
+type ActiveSketchTimeIndex struct {
+	TimeIndex  int64  `gorm:"primaryKey;not null"`
+	Accounts   []byte `gorm:"not null"`
+	Validators []byte `gorm:"not null"`
+}
+
+func NewActiveSketchTimeIndex(timeIndex int64, accounts []string, validators []string) (*ActiveSketchTimeIndex, error) {
+	accountSketch, err := newActiveSketch(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSketch, err := newActiveSketch(validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ActiveSketchTimeIndex{
+		TimeIndex:  timeIndex,
+		Accounts:   accountSketch,
+		Validators: validatorSketch,
+	}, nil
+}
+
+func newActiveSketch(addresses []string) ([]byte, error) {
+	sketch := hyperloglog.New14()
+
+	for _, address := range addresses {
+		sketch.Insert([]byte(address))
+	}
+
+	return sketch.MarshalBinary()
+}
+
+// estimated distinct active addresses between two timeindexes, both included
+type DistinctActive struct {
+	From       int64
+	To         int64
+	Days       int64
+	Accounts   int64
+	Validators int64
+}
+
+// MergeActiveSketches merges the daily sketches of a range into the estimated
+// distinct active accounts and validators
+func MergeActiveSketches(from int64, to int64, sketches []*ActiveSketchTimeIndex) (*DistinctActive, error) {
+	accounts := hyperloglog.New14()
+	validators := hyperloglog.New14()
+
+	for _, s := range sketches {
+		if err := mergeActiveSketch(accounts, s.Accounts); err != nil {
+			return nil, err
+		}
+
+		if err := mergeActiveSketch(validators, s.Validators); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DistinctActive{
+		From:       from,
+		To:         to,
+		Days:       int64(len(sketches)),
+		Accounts:   int64(accounts.Estimate()),
+		Validators: int64(validators.Estimate()),
+	}, nil
+}
+
+func mergeActiveSketch(sketch *hyperloglog.Sketch, data []byte) error {
+	other := hyperloglog.New14()
+
+	if err := other.UnmarshalBinary(data); err != nil {
+		return err
+	}
+
+	return sketch.Merge(other)
+}
+
+func (d *DistinctActive) ToProto() *api.DistinctActiveData {
+	return &api.DistinctActiveData{
+		From:       uint32(d.From),
+		To:         uint32(d.To),
+		Days:       d.Days,
+		Accounts:   d.Accounts,
+		Validators: d.Validators,
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+
+// daily inflows and outflows of an address, merged from the Tx*TimeIndex tables
+type AddressFlow struct {
+	TimeIndex   int64
+	TransferIn  int64
+	TransferOut int64
+	Reward      int64
+	BondIn      int64
+	BondOut     int64
+	WithdrawIn  int64
+	WithdrawOut int64
+}
+
+func (f *AddressFlow) Inflow() int64 {
+	return f.TransferIn + f.Reward + f.BondIn + f.WithdrawIn
+}
+
+func (f *AddressFlow) Outflow() int64 {
+	return f.TransferOut + f.BondOut + f.WithdrawOut
+}
+
+func (f *AddressFlow) ToProto() *api.AddressFlowData {
+	return &api.AddressFlowData{
+		TimeIndex:   uint32(f.TimeIndex),
+		Inflow:      f.Inflow(),
+		Outflow:     f.Outflow(),
+		TransferIn:  f.TransferIn,
+		TransferOut: f.TransferOut,
+		Reward:      f.Reward,
+		BondIn:      f.BondIn,
+		BondOut:     f.BondOut,
+		WithdrawIn:  f.WithdrawIn,
+		WithdrawOut: f.WithdrawOut,
+	}
+}
+
+// transfer volume between an address and one of its counterparties
+type AddressCounterparty struct {
+	Address  string
+	Sent     int64
+	Received int64
+}
+
+func (c *AddressCounterparty) ToProto() *api.AddressCounterpartyData {
+	return &api.AddressCounterpartyData{
+		Address:  c.Address,
+		Sent:     c.Sent,
+		Received: c.Received,
+	}
+}
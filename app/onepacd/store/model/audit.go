@@ -0,0 +1,86 @@
+package model
+
+import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+
+const (
+	AuditCheckStake          = "stake"
+	AuditCheckSupply         = "supply"
+	AuditCheckValidators     = "validators"
+	AuditCheckAccounts       = "accounts"
+	AuditCheckAccountBalance = "account_balance"
+	AuditCheckValidatorStake = "validator_stake"
+)
+
+// one run of the consistency auditor comparing indexed state with the node
+type AuditRun struct {
+	ID            int64 `gorm:"primaryKey;autoIncrement"`
+	Time          int64 `gorm:"index:idx_audit_run_time;not null"`
+	IndexedHeight int64 `gorm:"not null"`
+	NodeHeight    int64 `gorm:"not null"`
+	Checks        int64 `gorm:"not null"`
+	Discrepancies int64 `gorm:"not null"`
+
+	Records []*AuditRecord `gorm:"-:all"`
+}
+
+// a check of an audit run where the indexed value differs from the node
+type AuditRecord struct {
+	ID      int64  `gorm:"primaryKey;autoIncrement"`
+	RunID   int64  `gorm:"index:idx_audit_record_run;not null"`
+	Check   string `gorm:"not null"`
+	Subject string `gorm:"not null"`
+	Indexed int64  `gorm:"not null"`
+	Node    int64  `gorm:"not null"`
+}
+
+// Check counts a comparison and keeps a record when the values differ
+func (r *AuditRun) Check(check string, subject string, indexed int64, node int64) {
+	r.CheckWithin(check, subject, indexed, node, 0)
+}
+
+// CheckWithin counts a comparison and keeps a record when the values differ
+// by more than tolerance
+func (r *AuditRun) CheckWithin(check string, subject string, indexed int64, node int64, tolerance int64) {
+	r.Checks++
+
+	if diff := indexed - node; diff <= tolerance && -diff <= tolerance {
+		return
+	}
+
+	r.Discrepancies++
+	r.Records = append(r.Records, &AuditRecord{
+		Check:   check,
+		Subject: subject,
+		Indexed: indexed,
+		Node:    node,
+	})
+}
+
+func (r *AuditRun) ToProto() *api.AuditData {
+	records := make([]*api.AuditRecordData, 0, len(r.Records))
+	for _, record := range r.Records {
+		records = append(records, &api.AuditRecordData{
+			Check:   record.Check,
+			Subject: record.Subject,
+			Indexed: record.Indexed,
+			Node:    record.Node,
+		})
+	}
+
+	return &api.AuditData{
+		Time:          r.Time,
+		IndexedHeight: r.IndexedHeight,
+		NodeHeight:    r.NodeHeight,
+		Checks:        r.Checks,
+		Discrepancies: r.Discrepancies,
+		Records:       records,
+	}
+}
+
+// totals derived by the scanner at the latest committed height
+type IndexedTotals struct {
+	Height      int64
+	BondedStake int64
+	Validators  int64
+	Accounts    int64
+}
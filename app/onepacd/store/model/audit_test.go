@@ -0,0 +1,21 @@
+package model
+
+import "testing"
+
+func TestAuditRunCheckWithin(t *testing.T) {
+	run := &AuditRun{}
+
+	run.Check(AuditCheckAccounts, "", 10, 10)
+	run.CheckWithin(AuditCheckStake, "", 100, 95, 5)
+	run.CheckWithin(AuditCheckStake, "", 95, 100, 5)
+	run.CheckWithin(AuditCheckValidators, "", 7, 10, 2)
+	run.Check(AuditCheckSupply, "", 1, 2)
+
+	if run.Checks != 5 || run.Discrepancies != 2 {
+		t.Fatalf("checks = %d, discrepancies = %d, want 5 and 2", run.Checks, run.Discrepancies)
+	}
+
+	if run.Records[0].Check != AuditCheckValidators || run.Records[0].Indexed != 7 || run.Records[0].Node != 10 {
+		t.Errorf("unexpected record %+v", run.Records[0])
+	}
+}
@@ -1,6 +1,6 @@
 package model
 
 type Block struct {
-	TimeIndex int64 `gorm:"primaryKey;uniqueIndex:idx_time_index;not null"`
-	Height    int64 `gorm:"uniqueIndex:idx_height;not null"`
+	TimeIndex int64 `gorm:"primaryKey;not null"`
+	Height    int64 `gorm:"uniqueIndex:idx_block_height;not null"`
 }
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"slices"
+
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
+
+// per-day statistics of the intervals between consecutive blocks, in seconds
+type BlockTimingTimeIndex struct {
+	TimeIndex    int64   `gorm:"primaryKey;not null"`
+	Intervals    int64   `gorm:"not null"`
+	IntervalMean float64 `gorm:"not null"`
+	IntervalP50  int64   `gorm:"not null"`
+	IntervalP95  int64   `gorm:"not null"`
+	IntervalMax  int64   `gorm:"not null"`
+	// intervals over SlowThreshold seconds
+	SlowBlocks    int64 `gorm:"not null"`
+	SlowThreshold int64 `gorm:"not null"`
+	// blocks decided after the first consensus round
+	RoundChanges int64 `gorm:"not null"`
+	MaxRound     int32 `gorm:"not null"`
+
+	Rounds []*BlockRoundTimeIndex `gorm:"-:all"`
+}
+
+func (t *BlockTimingTimeIndex) ToProto() *api.BlockTimingData {
+	rounds := make([]*api.BlockRoundData, 0, len(t.Rounds))
+	for _, r := range t.Rounds {
+		rounds = append(rounds, &api.BlockRoundData{Round: r.Round, Blocks: r.Blocks})
+	}
+
+	return &api.BlockTimingData{
+		TimeIndex:     uint32(t.TimeIndex),
+		Intervals:     t.Intervals,
+		IntervalMean:  t.IntervalMean,
+		IntervalP50:   t.IntervalP50,
+		IntervalP95:   t.IntervalP95,
+		IntervalMax:   t.IntervalMax,
+		SlowBlocks:    t.SlowBlocks,
+		SlowThreshold: t.SlowThreshold,
+		RoundChanges:  t.RoundChanges,
+		MaxRound:      t.MaxRound,
+		Rounds:        rounds,
+	}
+}
+
+// blocks decided in a consensus round over timeindex
+type BlockRoundTimeIndex struct {
+	TimeIndex int64 `gorm:"primaryKey;not null"`
+	Round     int32 `gorm:"primaryKey;not null"`
+	Blocks    int64 `gorm:"not null"`
+}
+
+type txTimingMerged struct {
+	intervals     []int64
+	slow          int64
+	slowThreshold int64
+	rounds        map[int32]int64
+}
+
+func (t *txTimingMerged) toRow(timeIndex int64) *BlockTimingTimeIndex {
+	intervals := slices.Clone(t.intervals)
+	slices.Sort(intervals)
+
+	row := &BlockTimingTimeIndex{
+		TimeIndex:     timeIndex,
+		Intervals:     int64(len(intervals)),
+		IntervalP50:   percentile(intervals, 0.5),
+		IntervalP95:   percentile(intervals, 0.95),
+		SlowBlocks:    t.slow,
+		SlowThreshold: t.slowThreshold,
+	}
+
+	if len(intervals) > 0 {
+		var total int64
+		for _, interval := range intervals {
+			total += interval
+		}
+
+		row.IntervalMean = float64(total) / float64(len(intervals))
+		row.IntervalMax = intervals[len(intervals)-1]
+	}
+
+	for round, blocks := range t.rounds {
+		if round > 0 {
+			row.RoundChanges += blocks
+		}
+
+		row.MaxRound = max(row.MaxRound, round)
+	}
+
+	return row
+}
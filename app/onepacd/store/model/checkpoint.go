@@ -0,0 +1,15 @@
+package model
+
+const (
+	CheckpointChainscan = "chainscan"
+)
+
+// progress of a pipeline, written in the transaction of each period commit so
+// it never runs ahead of or behind the committed data
+type Checkpoint struct {
+	Name string `gorm:"primaryKey;not null"`
+	// last block height included in the committed periods
+	Height    int64 `gorm:"not null"`
+	TimeIndex int64 `gorm:"not null"`
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+}
@@ -1,27 +1,47 @@
 package model
 
-import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+import (
+	"maps"
+	"slices"
+
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
 
 type GlobalState struct {
-	TimeIndex         int64 `gorm:"primaryKey;uniqueIndex:idx_time_index;not null"`
+	TimeIndex         int64 `gorm:"primaryKey;not null"`
 	Stake             int64 `gorm:"not null"`
 	Supply            int64 `gorm:"not null"`
 	CirculatingSupply int64 `gorm:"not null"`
 	Txs               int64 `gorm:"not null"`
 	Blocks            int64 `gorm:"not null"`
 	Fee               int64 `gorm:"not null"`
+	// block rewards paid by the treasury
+	Reward int64 `gorm:"not null;default:0"`
 
 	ActiveValidator int64 `gorm:"not null"`
 	ActiveAccount   int64 `gorm:"not null"`
 
+	Sortitions        int64 `gorm:"not null;default:0"`
+	CommitteeSize     int64 `gorm:"not null;default:0"`
+	CommitteeTurnover int64 `gorm:"not null;default:0"`
+	CertSigned        int64 `gorm:"not null;default:0"`
+	CertMissed        int64 `gorm:"not null;default:0"`
+
 	ActiveValidatorDict map[string]bool `gorm:"-:all"`
 	ActiveAccountDict   map[string]bool `gorm:"-:all"`
+	Committee           map[int32]bool  `gorm:"-:all"`
+
+	Payloads []*PayloadStatTimeIndex `gorm:"-:all"`
+	// addresses active during the day, only set on commit copies
+	ActiveAccounts   []string `gorm:"-:all"`
+	ActiveValidators []string `gorm:"-:all"`
 }
 
 func NewGlobalState() *GlobalState {
 	return &GlobalState{
 		ActiveValidatorDict: make(map[string]bool),
 		ActiveAccountDict:   make(map[string]bool),
+		Committee:           make(map[int32]bool),
 	}
 }
 
@@ -30,6 +50,11 @@ func (g *GlobalState) Reset(timeIndex int64) {
 	g.Blocks = 0
 	g.Txs = 0
 	g.Fee = 0
+	g.Reward = 0
+	g.Sortitions = 0
+	g.CommitteeTurnover = 0
+	g.CertSigned = 0
+	g.CertMissed = 0
 	clear(g.ActiveValidatorDict)
 	clear(g.ActiveAccountDict)
 }
@@ -43,12 +68,64 @@ func (g *GlobalState) CreateCommitCopied() *GlobalState {
 		Txs:               g.Txs,
 		Blocks:            g.Blocks,
 		Fee:               g.Fee,
+		Reward:            g.Reward,
 		ActiveValidator:   int64(len(g.ActiveValidatorDict)),
 		ActiveAccount:     int64(len(g.ActiveAccountDict)),
+		Sortitions:        g.Sortitions,
+		CommitteeSize:     g.CommitteeSize,
+		CommitteeTurnover: g.CommitteeTurnover,
+		CertSigned:        g.CertSigned,
+		CertMissed:        g.CertMissed,
+		ActiveAccounts:    slices.Collect(maps.Keys(g.ActiveAccountDict)),
+		ActiveValidators:  slices.Collect(maps.Keys(g.ActiveValidatorDict)),
 	}
 }
 
+// UpdateCommittee replaces the tracked committee with the committers of a
+// block certificate and counts the validator numbers that joined it.
+func (g *GlobalState) UpdateCommittee(committers []int32) {
+	if len(committers) == 0 {
+		return
+	}
+
+	// the committee is unknown right after a restart
+	if len(g.Committee) > 0 {
+		for _, number := range committers {
+			if !g.Committee[number] {
+				g.CommitteeTurnover++
+			}
+		}
+	}
+
+	clear(g.Committee)
+	for _, number := range committers {
+		g.Committee[number] = true
+	}
+
+	g.CommitteeSize = int64(len(committers))
+}
+
+// Participation returns the share of committee signatures present in the
+// block certificates of the day
+func (g *GlobalState) Participation() float64 {
+	if g.CertSigned+g.CertMissed == 0 {
+		return 0
+	}
+
+	return float64(g.CertSigned) / float64(g.CertSigned+g.CertMissed)
+}
+
+// Yield returns the annualised staking yield of the day in percent
+func (g *GlobalState) Yield() float64 {
+	return AnnualisedYield(g.Reward, g.Stake, 1)
+}
+
 func (g *GlobalState) ToProto() *api.NetworkStatusData {
+	payloads := make([]*api.PayloadStatData, 0, len(g.Payloads))
+	for _, p := range g.Payloads {
+		payloads = append(payloads, p.ToProto())
+	}
+
 	return &api.NetworkStatusData{
 		TimeIndex:         uint32(g.TimeIndex),
 		Stake:             g.Stake,
@@ -57,7 +134,16 @@ func (g *GlobalState) ToProto() *api.NetworkStatusData {
 		Txs:               g.Txs,
 		Blocks:            g.Blocks,
 		Fee:               g.Fee,
+		Reward:            g.Reward,
 		ActiveValidator:   g.ActiveValidator,
 		ActiveAccount:     g.ActiveAccount,
+		Payloads:          payloads,
+		Sortitions:        g.Sortitions,
+		CommitteeSize:     g.CommitteeSize,
+		CommitteeTurnover: g.CommitteeTurnover,
+		CertSigned:        g.CertSigned,
+		CertMissed:        g.CertMissed,
+		Participation:     g.Participation(),
+		Yield:             g.Yield(),
 	}
 }
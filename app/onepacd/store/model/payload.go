@@ -0,0 +1,75 @@
+package model
+
+import (
+	"math"
+	"slices"
+
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
+
+// per-day transaction count, volume and fee statistics of a payload type
+type PayloadStatTimeIndex struct {
+	TimeIndex   int64 `gorm:"primaryKey;not null"`
+	PayloadType int32 `gorm:"primaryKey;not null"` // pactus.PayloadType
+	Txs         int64 `gorm:"not null"`
+	Volume      int64 `gorm:"not null"`
+	Fee         int64 `gorm:"not null"`
+	FeeMin      int64 `gorm:"not null"`
+	FeeMedian   int64 `gorm:"not null"`
+	FeeP90      int64 `gorm:"not null"`
+	FeeMax      int64 `gorm:"not null"`
+}
+
+func (p *PayloadStatTimeIndex) ToProto() *api.PayloadStatData {
+	return &api.PayloadStatData{
+		PayloadType: p.PayloadType,
+		Txs:         p.Txs,
+		Volume:      p.Volume,
+		Fee:         p.Fee,
+		FeeMin:      p.FeeMin,
+		FeeMedian:   p.FeeMedian,
+		FeeP90:      p.FeeP90,
+		FeeMax:      p.FeeMax,
+	}
+}
+
+type txPayloadMerged struct {
+	volume int64
+	fees   []int64
+}
+
+// percentile returns the nearest-rank percentile p of sorted values
+func percentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+
+	return sorted[max(rank, 0)]
+}
+
+func (t *txPayloadMerged) toRow(timeIndex int64, payloadType int32) *PayloadStatTimeIndex {
+	fees := slices.Clone(t.fees)
+	slices.Sort(fees)
+
+	row := &PayloadStatTimeIndex{
+		TimeIndex:   timeIndex,
+		PayloadType: payloadType,
+		Txs:         int64(len(fees)),
+		Volume:      t.volume,
+		FeeMedian:   percentile(fees, 0.5),
+		FeeP90:      percentile(fees, 0.9),
+	}
+
+	if len(fees) > 0 {
+		row.FeeMin = fees[0]
+		row.FeeMax = fees[len(fees)-1]
+	}
+
+	for _, fee := range fees {
+		row.Fee += fee
+	}
+
+	return row
+}
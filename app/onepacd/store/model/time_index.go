@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+const (
+	TimeIndexInterval     = 24 * 60 * 60
+	TimeIndexWeekInterval = 7 * TimeIndexInterval
+)
+
+// GetTimeIndex returns the time index (UTC day start) of a unix timestamp
+func GetTimeIndex(timestamp int64) int64 {
+	t := time.Unix(timestamp, 0).UTC()
+
+	year, month, day := t.Date()
+
+	dayStart := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	return dayStart.Unix()
+}
+
+// GetWeekTimeIndex returns the time index of the monday starting the week of
+// timeIndex
+func GetWeekTimeIndex(timeIndex int64) int64 {
+	// 1970-01-01 was a thursday
+	return timeIndex - ((timeIndex/TimeIndexInterval+3)%7)*TimeIndexInterval
+}
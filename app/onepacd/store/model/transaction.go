@@ -2,8 +2,8 @@ package model
 
 type TxTransferTimeIndex struct {
 	AddressFrom string `gorm:"primaryKey;not null"`
-	AddressTo   string `gorm:"primaryKey;not null"`
-	TimeIndex   int64  `gorm:"primaryKey;not null"`
+	AddressTo   string `gorm:"primaryKey;not null;index:idx_tx_transfer_to,priority:1"`
+	TimeIndex   int64  `gorm:"primaryKey;not null;index:idx_tx_transfer_to,priority:2"`
 	Amount      int64  `gorm:"not null"`
 }
 
@@ -15,8 +15,8 @@ type TxRewardTimeIndex struct {
 
 type TxBondTimeIndex struct {
 	AddressFrom string `gorm:"primaryKey;not null"`
-	AddressTo   string `gorm:"primaryKey;not null"`
-	TimeIndex   int64  `gorm:"primaryKey;not null"`
+	AddressTo   string `gorm:"primaryKey;not null;index:idx_tx_bond_to,priority:1"`
+	TimeIndex   int64  `gorm:"primaryKey;not null;index:idx_tx_bond_to,priority:2"`
 	Amount      int64  `gorm:"not null"`
 }
 
@@ -29,7 +29,7 @@ type TxUnbondTimeIndex struct {
 
 type TxWithdrawTimeIndex struct {
 	AddressFrom string `gorm:"primaryKey;not null"`
-	AddressTo   string `gorm:"primaryKey;not null"`
-	TimeIndex   int64  `gorm:"primaryKey;not null"`
+	AddressTo   string `gorm:"primaryKey;not null;index:idx_tx_withdraw_to,priority:1"`
+	TimeIndex   int64  `gorm:"primaryKey;not null;index:idx_tx_withdraw_to,priority:2"`
 	Amount      int64  `gorm:"not null"`
 }
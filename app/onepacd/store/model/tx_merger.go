@@ -12,6 +12,7 @@ type _TxUnbond struct {
 	Height int64
 	Time   int64
 	Hash   string
+	Stake  int64
 }
 
 type TxMerger struct {
@@ -27,8 +28,19 @@ type TxMerger struct {
 	withdrawSender   map[int64]map[string]*txTransferMerged
 	withdrawReceiver map[int64]map[string]*txTransferMerged
 
+	proposedBlocks map[int64]map[string]int64
+	sortitions     map[int64]map[string]int64
+	certSigned     map[int64]map[string]int64
+	certMissed     map[int64]map[string]int64
+
+	payloads map[int64]map[int32]*txPayloadMerged
+	timings  map[int64]*txTimingMerged
+
+	validatorEvents []*ValidatorEvent
+
 	accountBalanceChange map[string]int64
 	validatorStakeChange map[string]int64
+	validatorWithdrawn   map[string]int64
 }
 
 type txTransferMerged struct {
@@ -87,6 +99,25 @@ func (m *TxMerger) AddTransfer(timeIndex int64, sender string, receiver string,
 	return nil
 }
 
+// TransferRecipient is a receiver of a batch transfer
+type TransferRecipient struct {
+	Receiver string
+	Amount   int64
+}
+
+// AddBatchTransfer records the transfers of a batch transfer tx, whose fee the
+// sender pays once for all the recipients
+func (m *TxMerger) AddBatchTransfer(timeIndex int64, sender string, recipients []TransferRecipient, fee int64) error {
+	for _, recipient := range recipients {
+		if err := m.AddTransfer(timeIndex, sender, recipient.Receiver, recipient.Amount, fee); err != nil {
+			return err
+		}
+		fee = 0
+	}
+
+	return nil
+}
+
 func (m *TxMerger) AddReward(timeIndex int64, receiver string, amount int64, proposerAddress string) error {
 	if _, ok := m.transferReward[timeIndex]; !ok {
 		m.transferReward[timeIndex] = make(map[string]*txTransferMerged)
@@ -117,6 +148,48 @@ func (m *TxMerger) AddReward(timeIndex int64, receiver string, amount int64, pro
 	return nil
 }
 
+func (m *TxMerger) AddProposedBlock(timeIndex int64, proposerAddress string) error {
+	if _, ok := m.proposedBlocks[timeIndex]; !ok {
+		m.proposedBlocks[timeIndex] = make(map[string]int64)
+	}
+
+	m.proposedBlocks[timeIndex][proposerAddress]++
+
+	return nil
+}
+
+// AddCertificate records the committee members that signed a block
+// certificate and the absentees that did not
+func (m *TxMerger) AddCertificate(timeIndex int64, signers []string, absentees []string) error {
+	if _, ok := m.certSigned[timeIndex]; !ok {
+		m.certSigned[timeIndex] = make(map[string]int64)
+	}
+
+	if _, ok := m.certMissed[timeIndex]; !ok {
+		m.certMissed[timeIndex] = make(map[string]int64)
+	}
+
+	for _, validator := range signers {
+		m.certSigned[timeIndex][validator]++
+	}
+
+	for _, validator := range absentees {
+		m.certMissed[timeIndex][validator]++
+	}
+
+	return nil
+}
+
+func (m *TxMerger) AddSortition(timeIndex int64, validator string) error {
+	if _, ok := m.sortitions[timeIndex]; !ok {
+		m.sortitions[timeIndex] = make(map[string]int64)
+	}
+
+	m.sortitions[timeIndex][validator]++
+
+	return nil
+}
+
 func (m *TxMerger) AddBond(timeIndex int64, sender string, receiver string, stake int64, fee int64) error {
 	if _, ok := m.bondReceiver[timeIndex]; !ok {
 		m.bondReceiver[timeIndex] = make(map[string]*txTransferMerged)
@@ -161,13 +234,15 @@ func (m *TxMerger) AddBond(timeIndex int64, sender string, receiver string, stak
 	return nil
 }
 
-func (m *TxMerger) AddUnbond(timeIndex int64, validator string, height int64, hash string, time int64) error {
+// AddUnbond records an unbond transaction with the stake of the validator at
+// unbond time
+func (m *TxMerger) AddUnbond(timeIndex int64, validator string, height int64, hash string, time int64, stake int64) error {
 	if _, ok := m.unbond[timeIndex]; !ok {
 		m.unbond[timeIndex] = make(map[string]_TxUnbond)
 	}
 
 	if _, ok := m.unbond[timeIndex][validator]; !ok {
-		m.unbond[timeIndex][validator] = _TxUnbond{Height: height, Hash: hash, Time: time}
+		m.unbond[timeIndex][validator] = _TxUnbond{Height: height, Hash: hash, Time: time, Stake: stake}
 	} else {
 		return fmt.Errorf("unbond record already exists")
 	}
@@ -216,6 +291,76 @@ func (m *TxMerger) AddWithdraw(timeIndex int64, validator string, account string
 		m.validatorStakeChange[validator] -= (amount + fee)
 	}
 
+	m.validatorWithdrawn[validator] += amount + fee
+
+	return nil
+}
+
+// AddPayload records a non-subsidy transaction of the given pactus.PayloadType
+// for the payload type statistics
+func (m *TxMerger) AddPayload(timeIndex int64, payloadType int32, volume int64, fee int64) error {
+	if _, ok := m.payloads[timeIndex]; !ok {
+		m.payloads[timeIndex] = make(map[int32]*txPayloadMerged)
+	}
+
+	record, ok := m.payloads[timeIndex][payloadType]
+	if !ok {
+		record = &txPayloadMerged{}
+		m.payloads[timeIndex][payloadType] = record
+	}
+
+	record.volume += volume
+	record.fees = append(record.fees, fee)
+
+	return nil
+}
+
+func (m *TxMerger) timingOf(timeIndex int64) *txTimingMerged {
+	record, ok := m.timings[timeIndex]
+	if !ok {
+		record = &txTimingMerged{rounds: make(map[int32]int64)}
+		m.timings[timeIndex] = record
+	}
+
+	return record
+}
+
+// AddBlockInterval records the seconds between a block and its parent,
+// intervals over slowThreshold count as slow unless it is zero
+func (m *TxMerger) AddBlockInterval(timeIndex int64, interval int64, slowThreshold int64) error {
+	record := m.timingOf(timeIndex)
+
+	record.intervals = append(record.intervals, interval)
+	record.slowThreshold = slowThreshold
+
+	if slowThreshold > 0 && interval > slowThreshold {
+		record.slow++
+	}
+
+	return nil
+}
+
+// AddBlockRound records the consensus round a block was decided in
+func (m *TxMerger) AddBlockRound(timeIndex int64, round int32) error {
+	m.timingOf(timeIndex).rounds[round]++
+
+	return nil
+}
+
+// AddValidatorEvent records a bond, unbond or withdraw transaction for the
+// lifecycle of the validator
+func (m *TxMerger) AddValidatorEvent(timeIndex int64, validator string, eventType string, height int64, hash string, time int64, amount int64, account string) error {
+	m.validatorEvents = append(m.validatorEvents, &ValidatorEvent{
+		Hash:      hash,
+		Address:   validator,
+		TimeIndex: timeIndex,
+		Height:    height,
+		Time:      time,
+		Type:      eventType,
+		Amount:    amount,
+		Account:   account,
+	})
+
 	return nil
 }
 
@@ -228,6 +373,241 @@ func (m *TxMerger) Clean() {
 	m.withdrawReceiver = make(map[int64]map[string]*txTransferMerged)
 	m.withdrawSender = make(map[int64]map[string]*txTransferMerged)
 	m.unbond = make(map[int64]map[string]_TxUnbond)
+	m.proposedBlocks = make(map[int64]map[string]int64)
+	m.sortitions = make(map[int64]map[string]int64)
+	m.certSigned = make(map[int64]map[string]int64)
+	m.certMissed = make(map[int64]map[string]int64)
+	m.payloads = make(map[int64]map[int32]*txPayloadMerged)
+	m.timings = make(map[int64]*txTimingMerged)
+	m.validatorEvents = make([]*ValidatorEvent, 0)
 	m.accountBalanceChange = make(map[string]int64)
 	m.validatorStakeChange = make(map[string]int64)
+	m.validatorWithdrawn = make(map[string]int64)
+}
+
+func (m *TxMerger) AccountBalances() []*AccountBalance {
+	rows := make([]*AccountBalance, 0, len(m.accountBalanceChange))
+
+	for address, change := range m.accountBalanceChange {
+		rows = append(rows, &AccountBalance{Address: address, Balance: change})
+	}
+
+	return rows
+}
+
+func (m *TxMerger) AccountBalanceTimeIndexes(timeIndex int64) []*AccountBalanceTimeIndex {
+	rows := make([]*AccountBalanceTimeIndex, 0, len(m.accountBalanceChange))
+
+	for address, change := range m.accountBalanceChange {
+		rows = append(rows, &AccountBalanceTimeIndex{Address: address, TimeIndex: timeIndex, BalanceChange: change})
+	}
+
+	return rows
+}
+
+func (m *TxMerger) ValidatorStates() []*ValidatorState {
+	rows := make([]*ValidatorState, 0, len(m.validatorStakeChange))
+
+	for address, change := range m.validatorStakeChange {
+		rows = append(rows, &ValidatorState{Address: address, Stake: change, StakeMax: max(change, 0)})
+	}
+
+	return rows
+}
+
+func (m *TxMerger) ValidatorStakeTimeIndexes(timeIndex int64) []*ValidatorStakeTimeIndex {
+	rows := make([]*ValidatorStakeTimeIndex, 0, len(m.validatorStakeChange))
+
+	for address, change := range m.validatorStakeChange {
+		rows = append(rows, &ValidatorStakeTimeIndex{Address: address, TimeIndex: timeIndex, StakeChange: change})
+	}
+
+	return rows
+}
+
+func mergedToRows[T any](merged map[int64]map[string]*txTransferMerged, newRow func(timeIndex int64, from string, to string, amount int64) T) []T {
+	rows := make([]T, 0)
+
+	for timeIndex, record := range merged {
+		for from, transfer := range record {
+			for to, amount := range transfer.addresses {
+				rows = append(rows, newRow(timeIndex, from, to, amount))
+			}
+		}
+	}
+
+	return rows
+}
+
+func (m *TxMerger) TransferTimeIndexes() []*TxTransferTimeIndex {
+	return mergedToRows(m.transferSender, func(timeIndex int64, from string, to string, amount int64) *TxTransferTimeIndex {
+		return &TxTransferTimeIndex{AddressFrom: from, AddressTo: to, TimeIndex: timeIndex, Amount: amount}
+	})
+}
+
+func (m *TxMerger) RewardTimeIndexes() []*TxRewardTimeIndex {
+	rows := make([]*TxRewardTimeIndex, 0)
+
+	for timeIndex, record := range m.transferReward {
+		for receiver, reward := range record {
+			rows = append(rows, &TxRewardTimeIndex{Address: receiver, TimeIndex: timeIndex, Amount: reward.total})
+		}
+	}
+
+	return rows
+}
+
+func (m *TxMerger) BondTimeIndexes() []*TxBondTimeIndex {
+	return mergedToRows(m.bondSender, func(timeIndex int64, from string, to string, amount int64) *TxBondTimeIndex {
+		return &TxBondTimeIndex{AddressFrom: from, AddressTo: to, TimeIndex: timeIndex, Amount: amount}
+	})
+}
+
+func (m *TxMerger) UnbondTimeIndexes() []*TxUnbondTimeIndex {
+	rows := make([]*TxUnbondTimeIndex, 0)
+
+	for timeIndex, record := range m.unbond {
+		for validator, unbond := range record {
+			rows = append(rows, &TxUnbondTimeIndex{Address: validator, TimeIndex: timeIndex, Time: unbond.Time, Hash: unbond.Hash})
+		}
+	}
+
+	return rows
+}
+
+func (m *TxMerger) WithdrawTimeIndexes() []*TxWithdrawTimeIndex {
+	return mergedToRows(m.withdrawSender, func(timeIndex int64, from string, to string, amount int64) *TxWithdrawTimeIndex {
+		return &TxWithdrawTimeIndex{AddressFrom: from, AddressTo: to, TimeIndex: timeIndex, Amount: amount}
+	})
+}
+
+// ValidatorUnbonds returns the unbonds, whose maturity is left to the store
+// since it depends on the network
+func (m *TxMerger) ValidatorUnbonds() []*ValidatorUnbond {
+	rows := make([]*ValidatorUnbond, 0)
+
+	for timeIndex, record := range m.unbond {
+		for validator, unbond := range record {
+			rows = append(rows, &ValidatorUnbond{
+				Address:   validator,
+				TimeIndex: timeIndex,
+				Height:    unbond.Height,
+				Time:      unbond.Time,
+				Hash:      unbond.Hash,
+				Stake:     unbond.Stake,
+			})
+		}
+	}
+
+	return rows
+}
+
+// ValidatorWithdrawn returns the stake (amount plus fee) withdrawn from each
+// validator
+func (m *TxMerger) ValidatorWithdrawn() map[string]int64 {
+	return m.validatorWithdrawn
+}
+
+func (m *TxMerger) ValidatorStatTimeIndexes() []*ValidatorStatTimeIndex {
+	stats := make(map[int64]map[string]*ValidatorStatTimeIndex)
+
+	statOf := func(timeIndex int64, validator string) *ValidatorStatTimeIndex {
+		if _, ok := stats[timeIndex]; !ok {
+			stats[timeIndex] = make(map[string]*ValidatorStatTimeIndex)
+		}
+
+		stat, ok := stats[timeIndex][validator]
+		if !ok {
+			stat = &ValidatorStatTimeIndex{Address: validator, TimeIndex: timeIndex}
+			stats[timeIndex][validator] = stat
+		}
+
+		return stat
+	}
+
+	for timeIndex, record := range m.proposedBlocks {
+		for validator, blocks := range record {
+			statOf(timeIndex, validator).BlocksProposed += blocks
+		}
+	}
+
+	for timeIndex, record := range m.sortitions {
+		for validator, sortitions := range record {
+			statOf(timeIndex, validator).Sortitions += sortitions
+		}
+	}
+
+	for timeIndex, record := range m.certSigned {
+		for validator, certs := range record {
+			statOf(timeIndex, validator).CertsSigned += certs
+		}
+	}
+
+	for timeIndex, record := range m.certMissed {
+		for validator, certs := range record {
+			statOf(timeIndex, validator).CertsMissed += certs
+		}
+	}
+
+	for timeIndex, record := range m.transferReward {
+		for _, reward := range record {
+			for validator, amount := range reward.addresses {
+				statOf(timeIndex, validator).Reward += amount
+			}
+		}
+	}
+
+	rows := make([]*ValidatorStatTimeIndex, 0)
+
+	for _, record := range stats {
+		for _, stat := range record {
+			rows = append(rows, stat)
+		}
+	}
+
+	return rows
+}
+
+func (m *TxMerger) ValidatorRewardTimeIndexes() []*ValidatorRewardTimeIndex {
+	return mergedToRows(m.transferReward, func(timeIndex int64, receiver string, validator string, amount int64) *ValidatorRewardTimeIndex {
+		return &ValidatorRewardTimeIndex{Address: validator, TimeIndex: timeIndex, Receiver: receiver, Amount: amount}
+	})
+}
+
+func (m *TxMerger) PayloadStatTimeIndexes() []*PayloadStatTimeIndex {
+	rows := make([]*PayloadStatTimeIndex, 0)
+
+	for timeIndex, record := range m.payloads {
+		for payloadType, payload := range record {
+			rows = append(rows, payload.toRow(timeIndex, payloadType))
+		}
+	}
+
+	return rows
+}
+
+func (m *TxMerger) ValidatorEvents() []*ValidatorEvent {
+	return m.validatorEvents
+}
+
+func (m *TxMerger) BlockTimingTimeIndexes() []*BlockTimingTimeIndex {
+	rows := make([]*BlockTimingTimeIndex, 0, len(m.timings))
+
+	for timeIndex, record := range m.timings {
+		rows = append(rows, record.toRow(timeIndex))
+	}
+
+	return rows
+}
+
+func (m *TxMerger) BlockRoundTimeIndexes() []*BlockRoundTimeIndex {
+	rows := make([]*BlockRoundTimeIndex, 0)
+
+	for timeIndex, record := range m.timings {
+		for round, blocks := range record.rounds {
+			rows = append(rows, &BlockRoundTimeIndex{TimeIndex: timeIndex, Round: round, Blocks: blocks})
+		}
+	}
+
+	return rows
 }
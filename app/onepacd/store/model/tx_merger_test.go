@@ -0,0 +1,195 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
+)
+
+func balancesOf(rows []*AccountBalance) map[string]int64 {
+	balances := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		balances[row.Address] = row.Balance
+	}
+	return balances
+}
+
+func TestBatchTransferChargesFeeOnce(t *testing.T) {
+	m := NewTxMerger()
+
+	m.AddBatchTransfer(100, "sender", []TransferRecipient{
+		{Receiver: "a", Amount: 10},
+		{Receiver: "b", Amount: 20},
+		{Receiver: "c", Amount: 30},
+	}, 5)
+
+	balances := balancesOf(m.AccountBalances())
+
+	if balances["sender"] != -65 {
+		t.Errorf("sender balance change = %d, want -65", balances["sender"])
+	}
+
+	for address, want := range map[string]int64{"a": 10, "b": 20, "c": 30} {
+		if balances[address] != want {
+			t.Errorf("%s balance change = %d, want %d", address, balances[address], want)
+		}
+	}
+
+	var total int64
+	for _, row := range m.TransferTimeIndexes() {
+		if row.AddressFrom != "sender" || row.TimeIndex != 100 {
+			t.Errorf("unexpected transfer row %+v", row)
+		}
+		total += row.Amount
+	}
+
+	if total != 60 {
+		t.Errorf("transferred %d, want 60", total)
+	}
+}
+
+func TestAccountBalanceDeltas(t *testing.T) {
+	m := NewTxMerger()
+
+	m.AddTransfer(100, "alice", "bob", 50, 1)
+	m.AddTransfer(100, "bob", "alice", 20, 1)
+	m.AddBond(100, "alice", "validator", 100, 2)
+	m.AddWithdraw(100, "validator", "alice", 30, 1)
+
+	balances := balancesOf(m.AccountBalances())
+
+	// -50-1 +20 -100-2 +30
+	if balances["alice"] != -103 {
+		t.Errorf("alice balance change = %d, want -103", balances["alice"])
+	}
+
+	// +50 -20-1
+	if balances["bob"] != 29 {
+		t.Errorf("bob balance change = %d, want 29", balances["bob"])
+	}
+
+	for _, row := range m.AccountBalanceTimeIndexes(100) {
+		if row.TimeIndex != 100 || row.BalanceChange != balances[row.Address] {
+			t.Errorf("time index row %+v does not match the balance change", row)
+		}
+	}
+}
+
+func TestValidatorStateDeltas(t *testing.T) {
+	m := NewTxMerger()
+
+	m.AddBond(100, "alice", "bonded", 100, 0)
+	m.AddBond(100, "alice", "withdrawn", 10, 0)
+	m.AddWithdraw(100, "withdrawn", "alice", 40, 1)
+
+	states := make(map[string]*ValidatorState)
+	for _, row := range m.ValidatorStates() {
+		states[row.Address] = row
+	}
+
+	if s := states["bonded"]; s == nil || s.Stake != 100 || s.StakeMax != 100 {
+		t.Errorf("bonded state = %+v, want stake 100 and stake max 100", s)
+	}
+
+	// stake_max only grows with bonded stake
+	if s := states["withdrawn"]; s == nil || s.Stake != -31 || s.StakeMax != 0 {
+		t.Errorf("withdrawn state = %+v, want stake -31 and stake max 0", s)
+	}
+
+	if withdrawn := m.ValidatorWithdrawn()["withdrawn"]; withdrawn != 41 {
+		t.Errorf("withdrawn = %d, want 41", withdrawn)
+	}
+}
+
+func TestValidatorUnbondRows(t *testing.T) {
+	m := NewTxMerger()
+
+	if err := m.AddUnbond(100, "validator", 5000, "hash", 1700000000, 250); err != nil {
+		t.Fatalf("AddUnbond failed: %v", err)
+	}
+
+	if err := m.AddUnbond(100, "validator", 5001, "other", 1700000010, 250); err == nil {
+		t.Errorf("a second unbond of the validator in the period was accepted")
+	}
+
+	rows := m.ValidatorUnbonds()
+	if len(rows) != 1 {
+		t.Fatalf("got %d unbond rows, want 1", len(rows))
+	}
+
+	rules, err := constants.GetSupplyRules(constants.SupplyRulesMainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := rows[0]
+	row.SetMaturity(rules)
+
+	if row.Address != "validator" || row.TimeIndex != 100 || row.Height != 5000 || row.Hash != "hash" {
+		t.Errorf("unexpected unbond row %+v", row)
+	}
+
+	if row.Stake != 250 {
+		t.Errorf("stake = %d, want the stake at unbond time 250", row.Stake)
+	}
+
+	if row.MatureHeight != 5000+constants.UnbondInterval {
+		t.Errorf("mature height = %d, want %d", row.MatureHeight, 5000+constants.UnbondInterval)
+	}
+
+	if want := GetTimeIndex(1700000000 + constants.UnbondInterval*constants.BlockInterval); row.MatureTimeIndex != want {
+		t.Errorf("mature time index = %d, want %d", row.MatureTimeIndex, want)
+	}
+}
+
+func TestValidatorStatTimeIndexes(t *testing.T) {
+	m := NewTxMerger()
+
+	m.AddProposedBlock(100, "alice")
+	m.AddProposedBlock(100, "alice")
+	m.AddProposedBlock(100, "bob")
+	m.AddSortition(100, "bob")
+	m.AddCertificate(100, []string{"alice", "bob"}, []string{"carol"})
+	m.AddCertificate(100, []string{"alice"}, []string{"bob"})
+	m.AddReward(100, "alice-reward", 10, "alice")
+	m.AddReward(100, "alice-reward", 15, "alice")
+	m.AddReward(200, "bob", 7, "bob")
+
+	stats := make(map[int64]map[string]*ValidatorStatTimeIndex)
+	for _, row := range m.ValidatorStatTimeIndexes() {
+		if _, ok := stats[row.TimeIndex]; !ok {
+			stats[row.TimeIndex] = make(map[string]*ValidatorStatTimeIndex)
+		}
+		stats[row.TimeIndex][row.Address] = row
+	}
+
+	want := map[int64]map[string]ValidatorStatTimeIndex{
+		100: {
+			"alice": {BlocksProposed: 2, Reward: 25, CertsSigned: 2},
+			"bob":   {BlocksProposed: 1, Sortitions: 1, CertsSigned: 1, CertsMissed: 1},
+			"carol": {CertsMissed: 1},
+		},
+		200: {
+			"bob": {Reward: 7},
+		},
+	}
+
+	for timeIndex, validators := range want {
+		if len(stats[timeIndex]) != len(validators) {
+			t.Errorf("time index %d has %d rows, want %d", timeIndex, len(stats[timeIndex]), len(validators))
+		}
+
+		for address, w := range validators {
+			got := stats[timeIndex][address]
+			if got == nil {
+				t.Errorf("missing row of %s at %d", address, timeIndex)
+				continue
+			}
+
+			if got.BlocksProposed != w.BlocksProposed || got.Reward != w.Reward || got.Sortitions != w.Sortitions ||
+				got.CertsSigned != w.CertsSigned || got.CertsMissed != w.CertsMissed {
+				t.Errorf("row of %s at %d = %+v, want %+v", address, timeIndex, got, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
+
+// record of a validator unbond, from the unbond transaction until the stake
+// is withdrawn
+type ValidatorUnbond struct {
+	Address         string `gorm:"primaryKey;not null"`
+	TimeIndex       int64  `gorm:"not null"`
+	Height          int64  `gorm:"primaryKey;not null"`
+	Time            int64  `gorm:"not null"`
+	Hash            string `gorm:"not null"`
+	Stake           int64  `gorm:"not null"`
+	MatureHeight    int64  `gorm:"not null"`
+	MatureTimeIndex int64  `gorm:"index:idx_validator_unbond_mature;not null"`
+
+	Withdrawn          int64 `gorm:"not null"`
+	WithdrawnTimeIndex int64 `gorm:"not null"`
+}
+
+// SetMaturity sets when the unbonded stake becomes withdrawable under the
+// unbond interval of the network
+func (u *ValidatorUnbond) SetMaturity(rules constants.SupplyRules) {
+	u.MatureHeight = u.Height + rules.UnbondInterval()
+	u.MatureTimeIndex = GetTimeIndex(u.Time + rules.UnbondInterval()*rules.BlockInterval())
+}
+
+// unbonded stake that becomes withdrawable on a given day
+type UnbondForecastDay struct {
+	TimeIndex  int64
+	Amount     int64
+	Validators int64
+}
+
+func (d *UnbondForecastDay) ToProto() *api.UnbondForecastData {
+	return &api.UnbondForecastData{
+		TimeIndex:  uint32(d.TimeIndex),
+		Amount:     d.Amount,
+		Validators: d.Validators,
+	}
+}
+
+type UnbondForecast struct {
+	// matured stake that has not been withdrawn yet
+	Withdrawable int64
+	Days         []*UnbondForecastDay
+}
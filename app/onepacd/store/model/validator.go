@@ -0,0 +1,73 @@
+package model
+
+import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+
+// record of validator activity over timeindex
+type ValidatorStatTimeIndex struct {
+	Address        string `gorm:"primaryKey;not null"`
+	TimeIndex      int64  `gorm:"primaryKey;index:idx_validator_stat_time_index;not null"`
+	BlocksProposed int64  `gorm:"not null"`
+	Reward         int64  `gorm:"not null"`
+	// sortition transactions, i.e. committee joins
+	Sortitions int64 `gorm:"not null;default:0"`
+	// block certificates the validator signed or missed as a committee member
+	CertsSigned int64 `gorm:"not null;default:0"`
+	CertsMissed int64 `gorm:"not null;default:0"`
+	// bonded stake at the end of the period
+	Stake int64 `gorm:"not null"`
+}
+
+// record of the addresses a validator paid its block rewards to over timeindex
+type ValidatorRewardTimeIndex struct {
+	Address   string `gorm:"primaryKey;not null"`
+	TimeIndex int64  `gorm:"primaryKey;not null"`
+	Receiver  string `gorm:"primaryKey;not null"`
+	Amount    int64  `gorm:"not null"`
+}
+
+// validator activity over a period, either a single day or an aggregated window
+type ValidatorStat struct {
+	Address         string
+	TimeIndex       int64
+	BlocksProposed  int64
+	Reward          int64
+	Sortitions      int64
+	CertsSigned     int64
+	CertsMissed     int64
+	Stake           int64
+	RewardReceivers []string `gorm:"-:all"`
+	// annualised yield in percent of the reward on the stake
+	Yield float64 `gorm:"-:all"`
+}
+
+func (s *ValidatorStat) ToProto() *api.ValidatorStatData {
+	return &api.ValidatorStatData{
+		Address:         s.Address,
+		TimeIndex:       uint32(s.TimeIndex),
+		BlocksProposed:  s.BlocksProposed,
+		Reward:          s.Reward,
+		Sortitions:      s.Sortitions,
+		CertsSigned:     s.CertsSigned,
+		CertsMissed:     s.CertsMissed,
+		Stake:           s.Stake,
+		RewardReceivers: s.RewardReceivers,
+		Yield:           s.Yield,
+	}
+}
+
+// share of block certificates a validator signed over a window
+type ValidatorUptime struct {
+	Address     string
+	CertsSigned int64
+	CertsMissed int64
+	Uptime      float64
+}
+
+func (u *ValidatorUptime) ToProto() *api.ValidatorUptimeData {
+	return &api.ValidatorUptimeData{
+		Address:     u.Address,
+		CertsSigned: u.CertsSigned,
+		CertsMissed: u.CertsMissed,
+		Uptime:      u.Uptime,
+	}
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
+
+const (
+	ValidatorEventBond     = "bond"
+	ValidatorEventTopUp    = "top_up"
+	ValidatorEventUnbond   = "unbond"
+	ValidatorEventWithdraw = "withdraw"
+)
+
+// record of a transaction changing the lifecycle of a validator. Bonds are
+// recorded as ValidatorEventBond and split into first bond and top-ups on
+// commit.
+type ValidatorEvent struct {
+	Hash      string `gorm:"primaryKey;not null"`
+	Address   string `gorm:"index:idx_validator_event_address;not null"`
+	TimeIndex int64  `gorm:"not null"`
+	Height    int64  `gorm:"not null"`
+	Time      int64  `gorm:"not null"`
+	Type      string `gorm:"not null"`
+	Amount    int64  `gorm:"not null"`
+	// bond sender or withdraw receiver
+	Account string `gorm:"not null"`
+}
+
+func (e *ValidatorEvent) ToProto() *api.ValidatorEventData {
+	return &api.ValidatorEventData{
+		Hash:      e.Hash,
+		TimeIndex: uint32(e.TimeIndex),
+		Height:    e.Height,
+		Time:      e.Time,
+		Type:      e.Type,
+		Amount:    e.Amount,
+		Account:   e.Account,
+	}
+}
+
+// lifecycle milestones of a validator, starting with the first bond seen by
+// the indexer. Zero values mark milestones not reached yet.
+type ValidatorLifecycle struct {
+	Address         string `gorm:"primaryKey;not null"`
+	BondTimeIndex   int64  `gorm:"not null"`
+	BondHeight      int64  `gorm:"not null"`
+	UnbondTimeIndex int64  `gorm:"index:idx_validator_lifecycle_unbond;not null"`
+	// day of the final withdrawal, which leaves no stake
+	WithdrawTimeIndex int64 `gorm:"not null"`
+}
+
+// daily validator set churn
+type ValidatorChurnTimeIndex struct {
+	TimeIndex int64 `gorm:"primaryKey;not null"`
+	New       int64 `gorm:"not null"`
+	Exited    int64 `gorm:"not null"`
+	Withdrawn int64 `gorm:"not null"`
+	// bonded validators that have not unbonded at the end of the period
+	Live int64 `gorm:"not null"`
+}
+
+func (c *ValidatorChurnTimeIndex) ToProto() *api.ValidatorChurnData {
+	return &api.ValidatorChurnData{
+		TimeIndex: uint32(c.TimeIndex),
+		New:       c.New,
+		Exited:    c.Exited,
+		Withdrawn: c.Withdrawn,
+		Live:      c.Live,
+	}
+}
+
+// ApplyValidatorEvents classifies the bonds of a period into first bonds and
+// top-ups and advances the lifecycles of the validators. lifecycles holds the
+// known lifecycles by address and receives the new ones, stakes holds the
+// stake of the validators at the end of the period. A validator is withdrawn
+// once a withdrawal leaves it no stake, a partial withdrawal is not its exit.
+// Re-applying the events of an already committed period gives the same
+// result.
+func ApplyValidatorEvents(timeIndex int64, events []*ValidatorEvent, lifecycles map[string]*ValidatorLifecycle, stakes map[string]int64) *ValidatorChurnTimeIndex {
+	churn := &ValidatorChurnTimeIndex{TimeIndex: timeIndex}
+	bonded := make(map[string]bool)
+	withdrawn := make(map[string]bool)
+
+	// the events of a block stay in transaction order
+	slices.SortStableFunc(events, func(a, b *ValidatorEvent) int {
+		return cmp.Compare(a.Height, b.Height)
+	})
+
+	for _, e := range events {
+		lifecycle, ok := lifecycles[e.Address]
+		if !ok {
+			// validators unbonding without a bond seen, e.g. the genesis ones,
+			// start with their exit
+			lifecycle = &ValidatorLifecycle{Address: e.Address}
+			if e.Type == ValidatorEventBond || e.Type == ValidatorEventTopUp {
+				lifecycle.BondTimeIndex = e.TimeIndex
+				lifecycle.BondHeight = e.Height
+			}
+			lifecycles[e.Address] = lifecycle
+		}
+
+		switch e.Type {
+		case ValidatorEventBond, ValidatorEventTopUp:
+			// a later bond of the block of the first one is a top-up
+			if lifecycle.BondHeight == e.Height && !bonded[e.Address] {
+				e.Type = ValidatorEventBond
+				bonded[e.Address] = true
+				churn.New++
+			} else {
+				e.Type = ValidatorEventTopUp
+			}
+		case ValidatorEventUnbond:
+			lifecycle.UnbondTimeIndex = e.TimeIndex
+			churn.Exited++
+		case ValidatorEventWithdraw:
+			if stakes[e.Address] > 0 {
+				continue
+			}
+
+			if lifecycle.WithdrawTimeIndex == 0 || lifecycle.WithdrawTimeIndex == e.TimeIndex {
+				lifecycle.WithdrawTimeIndex = e.TimeIndex
+				withdrawn[e.Address] = true
+			}
+		}
+	}
+
+	churn.Withdrawn = int64(len(withdrawn))
+
+	return churn
+}
@@ -0,0 +1,49 @@
+package model
+
+import "testing"
+
+func TestApplyValidatorEventsBondsOfOneBlock(t *testing.T) {
+	events := []*ValidatorEvent{
+		{Hash: "b", Address: "validator", TimeIndex: 100, Height: 10, Type: ValidatorEventBond, Amount: 5},
+		{Hash: "a", Address: "validator", TimeIndex: 100, Height: 10, Type: ValidatorEventBond, Amount: 7},
+		{Hash: "c", Address: "validator", TimeIndex: 100, Height: 12, Type: ValidatorEventBond, Amount: 1},
+	}
+	lifecycles := make(map[string]*ValidatorLifecycle)
+
+	for run := 0; run < 2; run++ {
+		churn := ApplyValidatorEvents(100, events, lifecycles, nil)
+
+		if churn.New != 1 {
+			t.Errorf("run %d: new = %d, want 1", run, churn.New)
+		}
+
+		if events[0].Type != ValidatorEventBond || events[1].Type != ValidatorEventTopUp || events[2].Type != ValidatorEventTopUp {
+			t.Errorf("run %d: types %s %s %s", run, events[0].Type, events[1].Type, events[2].Type)
+		}
+	}
+}
+
+func TestApplyValidatorEventsFinalWithdrawal(t *testing.T) {
+	lifecycles := map[string]*ValidatorLifecycle{
+		"validator": {Address: "validator", BondTimeIndex: 100, BondHeight: 10, UnbondTimeIndex: 200},
+	}
+
+	partial := []*ValidatorEvent{
+		{Hash: "a", Address: "validator", TimeIndex: 300, Height: 30, Type: ValidatorEventWithdraw, Amount: 40},
+	}
+
+	churn := ApplyValidatorEvents(300, partial, lifecycles, map[string]int64{"validator": 60})
+	if churn.Withdrawn != 0 || lifecycles["validator"].WithdrawTimeIndex != 0 {
+		t.Errorf("a partial withdrawal marked the validator withdrawn: %+v", *lifecycles["validator"])
+	}
+
+	final := []*ValidatorEvent{
+		{Hash: "b", Address: "validator", TimeIndex: 400, Height: 40, Type: ValidatorEventWithdraw, Amount: 30},
+		{Hash: "c", Address: "validator", TimeIndex: 400, Height: 41, Type: ValidatorEventWithdraw, Amount: 29},
+	}
+
+	churn = ApplyValidatorEvents(400, final, lifecycles, map[string]int64{"validator": 0})
+	if churn.Withdrawn != 1 || lifecycles["validator"].WithdrawTimeIndex != 400 {
+		t.Errorf("withdrawn = %d, lifecycle %+v", churn.Withdrawn, *lifecycles["validator"])
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"math"
+
+	"github.com/1pactus/1pactus-react/proto/gen/go/api"
+)
+
+// daily snapshot of how the balances of funded accounts are distributed,
+// either over all accounts or without the labelled reserve accounts
+type WealthDistributionTimeIndex struct {
+	TimeIndex      int64   `gorm:"primaryKey;not null"`
+	ExcludeReserve bool    `gorm:"primaryKey;not null"`
+	Accounts       int64   `gorm:"not null"`
+	Total          int64   `gorm:"not null"`
+	Gini           float64 `gorm:"not null"`
+	Top10          int64   `gorm:"not null"`
+	Top100         int64   `gorm:"not null"`
+	Top1000        int64   `gorm:"not null"`
+
+	Buckets []*WealthBucketTimeIndex `gorm:"-:all"`
+}
+
+// accounts of a snapshot whose balance lies in [10^Exponent, 10^(Exponent+1))
+// NanoPAC
+type WealthBucketTimeIndex struct {
+	TimeIndex      int64 `gorm:"primaryKey;not null"`
+	ExcludeReserve bool  `gorm:"primaryKey;not null"`
+	Exponent       int32 `gorm:"primaryKey;not null"`
+	Accounts       int64 `gorm:"not null"`
+	Balance        int64 `gorm:"not null"`
+}
+
+func share(part int64, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(part) / float64(total)
+}
+
+func (w *WealthDistributionTimeIndex) ToProto() *api.WealthDistributionData {
+	buckets := make([]*api.WealthBucketData, 0, len(w.Buckets))
+	for _, b := range w.Buckets {
+		buckets = append(buckets, &api.WealthBucketData{
+			MinBalance: int64(math.Pow10(int(b.Exponent))),
+			Accounts:   b.Accounts,
+			Balance:    b.Balance,
+		})
+	}
+
+	return &api.WealthDistributionData{
+		TimeIndex:    uint32(w.TimeIndex),
+		Accounts:     w.Accounts,
+		Total:        w.Total,
+		Gini:         w.Gini,
+		Top10Share:   share(w.Top10, w.Total),
+		Top100Share:  share(w.Top100, w.Total),
+		Top1000Share: share(w.Top1000, w.Total),
+		Buckets:      buckets,
+	}
+}
+
+type RichListEntry struct {
+	Address string
+	Balance int64
+	Label   string
+	// share of the balances of all funded accounts in the same list
+	Share float64
+}
+
+func (r *RichListEntry) ToProto() *api.RichListEntryData {
+	return &api.RichListEntryData{
+		Address: r.Address,
+		Balance: r.Balance,
+		Label:   r.Label,
+		Share:   r.Share,
+	}
+}
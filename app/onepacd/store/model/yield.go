@@ -0,0 +1,54 @@
+package model
+
+import "github.com/1pactus/1pactus-react/proto/gen/go/api"
+
+const (
+	DaysPerYear = 365
+)
+
+// AnnualisedYield returns the yearly yield in percent of earning reward on
+// stake over the given number of days
+func AnnualisedYield(reward int64, stake int64, days int64) float64 {
+	if stake <= 0 || days <= 0 {
+		return 0
+	}
+
+	return float64(reward) / float64(stake) * DaysPerYear / float64(days) * 100
+}
+
+// network-wide staking yield over a window of days
+type StakingYield struct {
+	Days     int64
+	Reward   int64
+	AvgStake int64
+	Yield    float64
+	Lines    []*GlobalState
+}
+
+// ProjectedReward returns the reward a stake would earn over a year and over
+// a day at the yield of the window
+func (y *StakingYield) ProjectedReward(stake int64) (int64, int64) {
+	yearly := float64(stake) * y.Yield / 100
+
+	return int64(yearly), int64(yearly / DaysPerYear)
+}
+
+func (y *StakingYield) ToProto() *api.StakingYieldData {
+	lines := make([]*api.StakingYieldLineData, 0, len(y.Lines))
+	for _, g := range y.Lines {
+		lines = append(lines, &api.StakingYieldLineData{
+			TimeIndex: uint32(g.TimeIndex),
+			Reward:    g.Reward,
+			Stake:     g.Stake,
+			Yield:     g.Yield(),
+		})
+	}
+
+	return &api.StakingYieldData{
+		Days:     y.Days,
+		Reward:   y.Reward,
+		AvgStake: y.AvgStake,
+		Yield:    y.Yield,
+		Lines:    lines,
+	}
+}
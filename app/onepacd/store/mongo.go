@@ -1,10 +1,10 @@
 package store
 
-/**
 import (
-	"log"
+	"context"
 	"time"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/config"
 	"github.com/1pactus/1pactus-react/store/storedriver"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -12,38 +12,43 @@ import (
 
 const (
 	MONGO_DB_TIMEOUT = 10 * time.Second
+	// a period commit holds many bulk writes in one transaction
+	MONGO_COMMIT_TIMEOUT = 60 * time.Second
 )
 
+// mongoStore keeps a network in the mongo collections written before the
+// postgres store, extended with the chainscan checkpoint
 type mongoStore struct {
 	storedriver.Mongo
 
-	collection *DbCollection
-	db         *DbClient
+	rules constants.SupplyRules
+	db    *DbClient
 }
 
 func (s *mongoStore) Init(store storedriver.Mongo, conf *config.MongoConfig) {
 	s.Mongo = store
 
-	s.db = NewDBClient()
-	// Adapting old database connection code
-	if err := s.db.Connect(s.GetDatabase()); err != nil {
-		log.Fatalf("error to connect db")
+	if s.db == nil {
+		s.db = NewDBClient()
 	}
 
-	s.collection = s.db.collection
+	s.db.Connect(s.GetDatabase())
 }
 
 func (s *mongoStore) Indexes() map[*mongo.Collection][]mongo.IndexModel {
-	return map[*mongo.Collection][]mongo.IndexModel{
-		s.player: {
-			{
-				Keys:    bson.D{{Key: "user_id", Value: 1}},
-				Options: options.Index().SetUnique(true),
-			},
-		},
-	}
+	return s.db.Indexes()
+}
+
+func (s *mongoStore) Seed() error {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
+
+	return s.db.seedGenesisBalance(ctx, s.rules.GenesisAccounts())
 }
 
-func (s *mongoStore) GetDBAdapter() *DbClient {
-	return s.db
-}**/
+func (s *mongoStore) Commit(commitContext PgCommitContext) error {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_COMMIT_TIMEOUT)
+	defer cancel()
+
+	return s.db.Commit(ctx, commitContext)
+}
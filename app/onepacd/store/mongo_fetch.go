@@ -1,134 +1,307 @@
 package store
 
-/*
-
 import (
+	"cmp"
 	"context"
-	"fmt"
+	"slices"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/data"
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
 )
 
-func (s *mongoStore) GetNetworkGlobalStats(count int64) ([]data.GlobalStateData, error) {
+func (s *mongoStore) GetTopGlobalState() (*model.GlobalState, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
 	defer cancel()
 
-	var rets []data.GlobalStateData
+	state, err := s.db.GetTopGlobalState(ctx)
+	if err != nil || state == nil {
+		return nil, err
+	}
+
+	return state.ToModel(), nil
+}
 
-	opts := options.Find().
-		SetSort(bson.D{{Key: "time_index", Value: -1}})
+func (s *mongoStore) InsertGlobalState(state *model.GlobalState) error {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
 
-	if count > 0 {
-		opts = opts.SetLimit(count)
-	}
+	return s.db.InsertGlobalState(ctx, state)
+}
+
+// GetNetworkGlobalStats returns the global states without their payload
+// stats, which the mongo store does not keep
+func (s *mongoStore) GetNetworkGlobalStats(count int64) ([]model.GlobalState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
 
-	cursor, err := s.collection.global_state_index.Find(ctx, bson.D{}, opts)
+	states, err := s.db.GetGlobalStates(ctx, count)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	if err := cursor.All(ctx, &rets); err != nil {
-		return nil, err
+	rets := make([]model.GlobalState, 0, len(states))
+	for _, state := range states {
+		rets = append(rets, *state.ToModel())
 	}
 
 	return rets, nil
 }
 
-func (s *mongoStore) GetLastDaysTimeIndex(ctx context.Context, days int) ([]int64, error) {
-	if days < 1 {
-		return nil, fmt.Errorf("days must be greater than 0")
+func (s *mongoStore) GetTopBlock() (*model.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
+
+	block, err := s.db.GetTopBlock(ctx)
+	if err != nil || block == nil {
+		return nil, err
 	}
 
-	var timeIndexes []int64
+	return block.ToModel(), nil
+}
 
-	opts := options.Find().SetSort(bson.D{{Key: "time_index", Value: -1}}).SetLimit(int64(days))
+func (s *mongoStore) GetCheckpoint(name string) (*model.Checkpoint, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
 
-	cursor, err := s.collection.block.Find(ctx, bson.D{}, opts)
-	if err != nil {
+	checkpoint, err := s.db.GetCheckpoint(ctx, name)
+	if err != nil || checkpoint == nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	for cursor.Next(ctx) {
-		var result struct {
-			TimeIndex int64 `bson:"time_index"`
+	return checkpoint.ToModel(), nil
+}
+
+func (s *mongoStore) GetValidatorStake(address string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
+
+	stake, err := s.db.GetValidatorStake(ctx, address)
+	if err != nil || stake == nil {
+		return 0, err
+	}
+
+	return stake.Stake, nil
+}
+
+func (s *mongoStore) GetAddressFlows(address string, days int64) ([]*model.AddressFlow, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
+	defer cancel()
+
+	since := sinceTimeIndex(days)
+	collection := s.db.collection
+
+	flows := make(map[int64]*model.AddressFlow)
+	flowOf := func(timeIndex int64) *model.AddressFlow {
+		if flow, ok := flows[timeIndex]; ok {
+			return flow
 		}
-		if err := cursor.Decode(&result); err != nil {
+		flow := &model.AddressFlow{TimeIndex: timeIndex}
+		flows[timeIndex] = flow
+		return flow
+	}
+
+	sources := []struct {
+		documents func() ([]*data.AddressIndexData, error)
+		apply     func(flow *model.AddressFlow, doc *data.AddressIndexData)
+	}{
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_transfer_receiver_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.TransferIn += d.ReceiverAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_transfer_sender_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.TransferOut += d.SenderAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_transfer_reward_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.Reward += d.RewardAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_bond_receiver_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.BondIn += d.ReceiverAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_bond_sender_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.BondOut += d.SenderAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_withdraw_receiver_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.WithdrawIn += d.ReceiverAmount },
+		},
+		{
+			func() ([]*data.AddressIndexData, error) {
+				return findAddressIndex(ctx, collection.address_withdraw_sender_index, address, since)
+			},
+			func(f *model.AddressFlow, d *data.AddressIndexData) { f.WithdrawOut += d.SenderAmount },
+		},
+	}
+
+	for _, source := range sources {
+		docs, err := source.documents()
+		if err != nil {
 			return nil, err
 		}
-		timeIndexes = append(timeIndexes, result.TimeIndex)
+
+		for _, doc := range docs {
+			source.apply(flowOf(doc.TimeIndex), doc)
+		}
 	}
 
-	return timeIndexes, nil
+	rets := make([]*model.AddressFlow, 0, len(flows))
+	for _, flow := range flows {
+		rets = append(rets, flow)
+	}
+
+	slices.SortFunc(rets, func(a, b *model.AddressFlow) int {
+		return cmp.Compare(a.TimeIndex, b.TimeIndex)
+	})
+
+	return rets, nil
 }
 
-func (s *mongoStore) GetUnbond(days int) (map[int64]int64, error) {
+// GetAddressCounterparties sums the counterparty maps of the transfer
+// documents of the address
+func (s *mongoStore) GetAddressCounterparties(address string, days int64, limit int) ([]*model.AddressCounterparty, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), MONGO_DB_TIMEOUT)
-
 	defer cancel()
 
-	timeIndexes, err := s.GetLastDaysTimeIndex(ctx, days)
+	since := sinceTimeIndex(days)
+
+	counterparties := make(map[string]*model.AddressCounterparty)
+	counterpartyOf := func(address string) *model.AddressCounterparty {
+		if counterparty, ok := counterparties[address]; ok {
+			return counterparty
+		}
+		counterparty := &model.AddressCounterparty{Address: address}
+		counterparties[address] = counterparty
+		return counterparty
+	}
 
+	sent, err := findAddressIndex(ctx, s.db.collection.address_transfer_sender_index, address, since)
 	if err != nil {
 		return nil, err
 	}
 
-	cursor0, err := s.collection.address_unbond_index.Find(ctx, bson.D{
-		{Key: "time_index", Value: bson.D{
-			{Key: "$in", Value: timeIndexes},
-		}},
-	})
+	for _, doc := range sent {
+		for to, amount := range doc.SenderMap {
+			counterpartyOf(to).Sent += amount
+		}
+	}
 
+	received, err := findAddressIndex(ctx, s.db.collection.address_transfer_receiver_index, address, since)
 	if err != nil {
 		return nil, err
 	}
-	defer cursor0.Close(ctx)
 
-	addresses := make([]string, 0)
-
-	if cursor0.Next(ctx) {
-		var result struct {
-			Address string `bson:"address"`
-		}
-		if err := cursor0.Decode(&result); err != nil {
-			return nil, err
+	for _, doc := range received {
+		for from, amount := range doc.ReceiverMap {
+			counterpartyOf(from).Received += amount
 		}
-		addresses = append(addresses, result.Address)
 	}
 
-	cursor1, err := s.collection.validator_stake.Find(ctx, bson.D{
-		{
-			Key: "address", Value: bson.D{
-				{Key: "$in", Value: addresses},
-			},
-		},
+	rets := make([]*model.AddressCounterparty, 0, len(counterparties))
+	for _, counterparty := range counterparties {
+		rets = append(rets, counterparty)
+	}
+
+	slices.SortFunc(rets, func(a, b *model.AddressCounterparty) int {
+		return cmp.Compare(b.Sent+b.Received, a.Sent+a.Received)
 	})
 
-	if err != nil {
-		return nil, err
+	if limit > 0 && len(rets) > limit {
+		rets = rets[:limit]
 	}
 
-	defer cursor1.Close(ctx)
+	return rets, nil
+}
 
-	unboundWithTimeIndex := make(map[int64]int64)
+// the mongo collections keep neither the per validator nor the per account
+// daily stats these queries read
 
-	for cursor1.Next(ctx) {
-		var result struct {
-			Address         string `bson:"address"`
-			StakeMax        int64  `bson:"stake_max"`
-			UnbondTimeIndex int64  `bson:"unbond_time_index"`
-		}
+func (s *mongoStore) GetBlockTiming(days int64) ([]*model.BlockTimingTimeIndex, error) {
+	return nil, ErrUnsupported
+}
 
-		if err := cursor1.Decode(&result); err != nil {
-			return nil, err
-		}
+func (s *mongoStore) GetUnbondForecast(days int64) (*model.UnbondForecast, error) {
+	return nil, ErrUnsupported
+}
 
-		unboundWithTimeIndex[result.UnbondTimeIndex] += result.StakeMax
-	}
+func (s *mongoStore) GetStakingYield(days int64) (*model.StakingYield, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetValidatorLeaderboard(days int64, limit int, orderBy string) ([]*model.ValidatorStat, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetValidatorHistory(address string, days int64) ([]*model.ValidatorStat, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetValidatorUptime(days int64, limit int, orderBy string) ([]*model.ValidatorUptime, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetValidatorChurn(days int64) ([]*model.ValidatorChurnTimeIndex, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetValidatorTimeline(address string) (*model.ValidatorLifecycle, []*model.ValidatorEvent, error) {
+	return nil, nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetRichList(limit int, excludeReserve bool) ([]*model.RichListEntry, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetWealthDistribution(days int64, excludeReserve bool) ([]*model.WealthDistributionTimeIndex, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetDistinctActive(from int64, to int64) (*model.DistinctActive, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetAccountActivity(days int64) ([]*model.AccountActivityTimeIndex, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetAccountRetention(weeks int64) ([]*model.AccountCohort, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetIndexedTotals() (*model.IndexedTotals, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetRandomAccountBalances(limit int) ([]*model.AccountBalance, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetAccountBalances(addresses []string) ([]*model.AccountBalance, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) GetRandomValidatorStates(limit int) ([]*model.ValidatorState, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *mongoStore) InsertAuditRun(run *model.AuditRun) error {
+	return ErrUnsupported
+}
 
-	return unboundWithTimeIndex, nil
+func (s *mongoStore) GetLatestAuditRun() (*model.AuditRun, error) {
+	return nil, ErrUnsupported
 }
-*/
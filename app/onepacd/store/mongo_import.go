@@ -0,0 +1,604 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/data"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/config"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MONGO_IMPORT_BATCH_SIZE is the default number of rows written per
+// transaction by the import, enough for them to be staged with COPY
+const MONGO_IMPORT_BATCH_SIZE = 10000
+
+// MongoImporter copies the collections written by DbClient.Commit into the
+// postgres tables of a network, so a network moves to postgres without a
+// rescan. The daily stats only postgres keeps, such as the validator stats
+// or the wealth distribution, start from the first period scanned after the
+// import.
+type MongoImporter struct {
+	mongo      *DbClient
+	db         *gorm.DB
+	partitions *storedriver.PostgresPartitioner
+	batchSize  int
+	log        log.ILogger
+}
+
+// MongoImportResult is the number of rows imported from a collection
+type MongoImportResult struct {
+	Name     string
+	Rows     int64
+	Duration time.Duration
+}
+
+// MongoImportCheck compares the rows and the amounts of a collection with the
+// table it is imported into
+type MongoImportCheck struct {
+	Name       string
+	MongoRows  int64
+	PgRows     int64
+	MongoTotal int64
+	PgTotal    int64
+}
+
+func (c *MongoImportCheck) Passed() bool {
+	return c.MongoRows == c.PgRows && c.MongoTotal == c.PgTotal
+}
+
+// mongoImportSource is a collection of the mongo layout and the table it is
+// imported into. The documents of pipeline each become a row of table, and
+// the sum of their field must match the sum of column. A source without copy
+// only checks a table another source imports, such as the receiver side of
+// the transfers.
+type mongoImportSource struct {
+	name       string
+	collection *mongo.Collection
+	pipeline   mongo.Pipeline
+	field      string
+	table      interface{}
+	column     string
+	copy       func(ctx context.Context, cursor *mongo.Cursor) (int64, error)
+}
+
+// importFlow is an amount by counterparty unwound from the map of an address
+// index document
+type importFlow struct {
+	TimeIndex    int64  `bson:"time_index"`
+	Address      string `bson:"address"`
+	Counterparty string `bson:"counterparty"`
+	Amount       int64  `bson:"amount"`
+}
+
+// ImportMongo opens the mongo database and the postgres schema of a network and
+// runs fn with an importer between them. The postgres schema must be migrated
+// to the latest version.
+func ImportMongo(config *config.ConfigBase, network *NetworkConfig, batchSize int, fn func(importer *MongoImporter) error) error {
+	mongoConf := *config.Mongo
+	if network.MongoDatabase != "" {
+		mongoConf.Database = network.MongoDatabase
+	}
+
+	mongoConn, err := storedriver.MongoConnect(network.Name, &mongoConf)
+	if err != nil {
+		return err
+	}
+	defer mongoConn.Close()
+
+	pgConf := *config.Postgres
+	pgConf.Schema = network.PostgresSchema
+
+	pgConn, err := storedriver.PostgresGormConnect(network.Name, &pgConf)
+	if err != nil {
+		return err
+	}
+	defer pgConn.Close()
+
+	migrations, err := storedriver.LoadMigrations((&postgresStore{}).Migrations())
+	if err != nil {
+		return err
+	}
+
+	if err := storedriver.NewPostgresMigrator(pgConn.GetDB(), migrations, log.WithKv("module", "migrate").WithKv("network", network.Name)).Check(); err != nil {
+		return err
+	}
+
+	if batchSize <= 0 {
+		batchSize = MONGO_IMPORT_BATCH_SIZE
+	}
+
+	client := NewDBClient()
+	client.Connect(mongoConn.GetDatabase())
+
+	importLog := log.WithKv("module", "import").WithKv("network", network.Name)
+
+	return fn(&MongoImporter{
+		mongo:      client,
+		db:         pgConn.GetDB(),
+		partitions: storedriver.NewPostgresPartitioner(pgConn.GetDB(), (&postgresStore{}).Tables(), importLog),
+		batchSize:  batchSize,
+		log:        importLog,
+	})
+}
+
+// replaceOnConflict overwrites the row of the conflict columns, so running an
+// import again leaves the same rows
+func replaceOnConflict(conflictColumns ...string) clause.OnConflict {
+	columns := make([]clause.Column, 0, len(conflictColumns))
+	for _, name := range conflictColumns {
+		columns = append(columns, clause.Column{Name: name})
+	}
+
+	return clause.OnConflict{Columns: columns, UpdateAll: true}
+}
+
+// sumPipeline groups the documents by keys and sums each of sums under its own
+// name. The mongo indexes never enforced unique keys, the grouping merges the
+// documents a postgres row is made of.
+func sumPipeline(keys []string, sums ...string) mongo.Pipeline {
+	id := bson.D{}
+	project := bson.D{{Key: "_id", Value: 0}}
+
+	for _, key := range keys {
+		id = append(id, bson.E{Key: key, Value: "$" + key})
+		project = append(project, bson.E{Key: key, Value: "$_id." + key})
+	}
+
+	group := bson.D{{Key: "_id", Value: id}}
+
+	for _, sum := range sums {
+		group = append(group, bson.E{Key: sum, Value: bson.D{{Key: "$sum", Value: "$" + sum}}})
+		project = append(project, bson.E{Key: sum, Value: 1})
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$group", Value: group}},
+		{{Key: "$project", Value: project}},
+	}
+}
+
+// unwindPipeline turns the counterparty map mapField of the address index
+// documents into one importFlow per time index, address and counterparty
+func unwindPipeline(mapField string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$project", Value: bson.D{
+			{Key: "time_index", Value: 1},
+			{Key: "address", Value: 1},
+			{Key: "entries", Value: bson.D{{Key: "$objectToArray", Value: "$" + mapField}}},
+		}}},
+		{{Key: "$unwind", Value: "$entries"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "time_index", Value: "$time_index"},
+				{Key: "address", Value: "$address"},
+				{Key: "counterparty", Value: "$entries.k"},
+			}},
+			{Key: "amount", Value: bson.D{{Key: "$sum", Value: "$entries.v"}}},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "time_index", Value: "$_id.time_index"},
+			{Key: "address", Value: "$_id.address"},
+			{Key: "counterparty", Value: "$_id.counterparty"},
+			{Key: "amount", Value: 1},
+		}}},
+	}
+}
+
+// copyRows decodes the documents of a cursor as D and writes them as rows T
+// in batches, each in its own transaction
+func copyRows[D any, T any](i *MongoImporter, name string, onConflict clause.OnConflict, toRow func(doc *D) *T) func(ctx context.Context, cursor *mongo.Cursor) (int64, error) {
+	return func(ctx context.Context, cursor *mongo.Cursor) (int64, error) {
+		var count int64
+		rows := make([]*T, 0, i.batchSize)
+
+		flush := func() error {
+			if len(rows) == 0 {
+				return nil
+			}
+
+			err := storedriver.CopyTransaction(ctx, i.db, func(tx *gorm.DB) error {
+				return upsertRows(tx, rows, onConflict)
+			})
+			if err != nil {
+				return err
+			}
+
+			count += int64(len(rows))
+			rows = rows[:0]
+			i.log.Debugf("%s: %d rows imported", name, count)
+
+			return nil
+		}
+
+		for cursor.Next(ctx) {
+			var doc D
+			if err := cursor.Decode(&doc); err != nil {
+				return count, err
+			}
+
+			rows = append(rows, toRow(&doc))
+
+			if len(rows) >= i.batchSize {
+				if err := flush(); err != nil {
+					return count, err
+				}
+			}
+		}
+
+		if err := cursor.Err(); err != nil {
+			return count, err
+		}
+
+		return count, flush()
+	}
+}
+
+// sources lists the collections in import order. chainscan resumes from the
+// checkpoint, or from the top block for a scan committed before checkpoints
+// were written, so both come last: an interrupted import must not look
+// complete.
+func (i *MongoImporter) sources() []*mongoImportSource {
+	c := i.mongo.collection
+	timeIndexAddress := []string{"time_index", "address"}
+
+	return []*mongoImportSource{
+		{
+			name:       "global_state",
+			collection: c.global_state_index,
+			field:      "txs",
+			table:      &model.GlobalState{},
+			column:     "txs",
+			copy:       copyRows(i, "global_state", replaceOnConflict("time_index"), (*data.GlobalStateData).ToModel),
+		},
+		{
+			name:       "account_balance",
+			collection: c.account_balance,
+			pipeline:   sumPipeline([]string{"address"}, "balance"),
+			field:      "balance",
+			table:      &model.AccountBalance{},
+			column:     "balance",
+			copy: copyRows(i, "account_balance", replaceOnConflict("address"), func(d *data.AccountBalanceData) *model.AccountBalance {
+				return &model.AccountBalance{Address: d.Address, Balance: d.Balance}
+			}),
+		},
+		{
+			name:       "account_balance_index",
+			collection: c.account_balance_index,
+			pipeline:   sumPipeline(timeIndexAddress, "balance"),
+			field:      "balance",
+			table:      &model.AccountBalanceTimeIndex{},
+			column:     "balance_change",
+			copy: copyRows(i, "account_balance_index", replaceOnConflict("address", "time_index"), func(d *data.AccountBalanceData) *model.AccountBalanceTimeIndex {
+				return &model.AccountBalanceTimeIndex{Address: d.Address, TimeIndex: d.TimeIndex, BalanceChange: d.Balance}
+			}),
+		},
+		{
+			name:       "validator_stake",
+			collection: c.validator_stake,
+			pipeline:   sumPipeline([]string{"address"}, "stake", "stake_max"),
+			field:      "stake",
+			table:      &model.ValidatorState{},
+			column:     "stake",
+			copy: copyRows(i, "validator_stake", replaceOnConflict("address"), func(d *data.ValidatorStakeData) *model.ValidatorState {
+				return &model.ValidatorState{Address: d.Address, Stake: d.Stake, StakeMax: d.StakeMax}
+			}),
+		},
+		{
+			name:       "transfer",
+			collection: c.address_transfer_sender_index,
+			pipeline:   unwindPipeline("sender_map"),
+			field:      "amount",
+			table:      &model.TxTransferTimeIndex{},
+			column:     "amount",
+			copy: copyRows(i, "transfer", replaceOnConflict("address_from", "address_to", "time_index"), func(d *importFlow) *model.TxTransferTimeIndex {
+				return &model.TxTransferTimeIndex{AddressFrom: d.Address, AddressTo: d.Counterparty, TimeIndex: d.TimeIndex, Amount: d.Amount}
+			}),
+		},
+		{
+			name:       "transfer_receiver",
+			collection: c.address_transfer_receiver_index,
+			pipeline:   unwindPipeline("receiver_map"),
+			field:      "amount",
+			table:      &model.TxTransferTimeIndex{},
+			column:     "amount",
+		},
+		{
+			name:       "reward",
+			collection: c.address_transfer_reward_index,
+			pipeline:   sumPipeline(timeIndexAddress, "reward_amount"),
+			field:      "reward_amount",
+			table:      &model.TxRewardTimeIndex{},
+			column:     "amount",
+			copy: copyRows(i, "reward", replaceOnConflict("address", "time_index"), func(d *data.AddressIndexData) *model.TxRewardTimeIndex {
+				return &model.TxRewardTimeIndex{Address: d.Address, TimeIndex: d.TimeIndex, Amount: d.RewardAmount}
+			}),
+		},
+		{
+			name:       "validator_reward",
+			collection: c.address_transfer_reward_index,
+			pipeline:   unwindPipeline("reward_map"),
+			field:      "amount",
+			table:      &model.ValidatorRewardTimeIndex{},
+			column:     "amount",
+			copy: copyRows(i, "validator_reward", replaceOnConflict("address", "time_index", "receiver"), func(d *importFlow) *model.ValidatorRewardTimeIndex {
+				return &model.ValidatorRewardTimeIndex{Address: d.Counterparty, TimeIndex: d.TimeIndex, Receiver: d.Address, Amount: d.Amount}
+			}),
+		},
+		{
+			name:       "bond",
+			collection: c.address_bond_sender_index,
+			pipeline:   unwindPipeline("sender_map"),
+			field:      "amount",
+			table:      &model.TxBondTimeIndex{},
+			column:     "amount",
+			copy: copyRows(i, "bond", replaceOnConflict("address_from", "address_to", "time_index"), func(d *importFlow) *model.TxBondTimeIndex {
+				return &model.TxBondTimeIndex{AddressFrom: d.Address, AddressTo: d.Counterparty, TimeIndex: d.TimeIndex, Amount: d.Amount}
+			}),
+		},
+		{
+			name:       "bond_receiver",
+			collection: c.address_bond_receiver_index,
+			pipeline:   unwindPipeline("receiver_map"),
+			field:      "amount",
+			table:      &model.TxBondTimeIndex{},
+			column:     "amount",
+		},
+		{
+			name:       "unbond",
+			collection: c.address_unbond_index,
+			pipeline: mongo.Pipeline{
+				{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: bson.D{{Key: "time_index", Value: "$time_index"}, {Key: "address", Value: "$address"}}},
+					{Key: "hash", Value: bson.D{{Key: "$last", Value: "$hash"}}},
+					{Key: "time", Value: bson.D{{Key: "$last", Value: "$time"}}},
+				}}},
+				{{Key: "$project", Value: bson.D{
+					{Key: "_id", Value: 0},
+					{Key: "time_index", Value: "$_id.time_index"},
+					{Key: "address", Value: "$_id.address"},
+					{Key: "hash", Value: 1},
+					{Key: "time", Value: 1},
+				}}},
+			},
+			table: &model.TxUnbondTimeIndex{},
+			copy: copyRows(i, "unbond", replaceOnConflict("address", "time_index"), func(d *data.UnbondData) *model.TxUnbondTimeIndex {
+				return &model.TxUnbondTimeIndex{Address: d.Address, TimeIndex: d.TimeIndex, Time: d.Time, Hash: d.Hash}
+			}),
+		},
+		{
+			name:       "withdraw",
+			collection: c.address_withdraw_sender_index,
+			pipeline:   unwindPipeline("sender_map"),
+			field:      "amount",
+			table:      &model.TxWithdrawTimeIndex{},
+			column:     "amount",
+			copy: copyRows(i, "withdraw", replaceOnConflict("address_from", "address_to", "time_index"), func(d *importFlow) *model.TxWithdrawTimeIndex {
+				return &model.TxWithdrawTimeIndex{AddressFrom: d.Address, AddressTo: d.Counterparty, TimeIndex: d.TimeIndex, Amount: d.Amount}
+			}),
+		},
+		{
+			name:       "withdraw_receiver",
+			collection: c.address_withdraw_receiver_index,
+			pipeline:   unwindPipeline("receiver_map"),
+			field:      "amount",
+			table:      &model.TxWithdrawTimeIndex{},
+			column:     "amount",
+		},
+		{
+			name:       "block",
+			collection: c.block,
+			field:      "height",
+			table:      &model.Block{},
+			column:     "height",
+			copy:       copyRows(i, "block", replaceOnConflict("time_index"), (*data.BlockData).ToModel),
+		},
+		{
+			name:       "checkpoint",
+			collection: c.checkpoint,
+			field:      "height",
+			table:      &model.Checkpoint{},
+			column:     "height",
+			copy:       copyRows(i, "checkpoint", replaceOnConflict("name"), (*data.CheckpointData).ToModel),
+		},
+	}
+}
+
+func (i *MongoImporter) aggregate(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	if pipeline == nil {
+		pipeline = mongo.Pipeline{}
+	}
+
+	return collection.Aggregate(ctx, pipeline, options.Aggregate().
+		SetAllowDiskUse(true).
+		SetBatchSize(int32(i.batchSize)))
+}
+
+// checkTarget refuses a postgres schema holding a scan other than the one
+// being imported, the import would mix both
+func (i *MongoImporter) checkTarget(ctx context.Context) error {
+	source, err := i.mongo.GetCheckpoint(ctx, model.CheckpointChainscan)
+	if err != nil {
+		return err
+	}
+
+	target := &model.Checkpoint{}
+	err = i.db.WithContext(ctx).Where("name = ?", model.CheckpointChainscan).First(target).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if source == nil || source.Height != target.Height {
+		return fmt.Errorf("postgres already holds a scan up to height %d, import into an empty schema", target.Height)
+	}
+
+	return nil
+}
+
+// ensurePartitions creates the monthly partitions of the periods of the mongo
+// blocks, the commit only creates those of the periods it writes
+func (i *MongoImporter) ensurePartitions(ctx context.Context) error {
+	var first data.BlockData
+
+	err := i.mongo.collection.block.FindOne(ctx, bson.D{}, options.FindOne().SetSort(bson.D{{Key: "time_index", Value: 1}})).Decode(&first)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var last data.BlockData
+
+	err = i.mongo.collection.block.FindOne(ctx, bson.D{}, options.FindOne().SetSort(bson.D{{Key: "time_index", Value: -1}})).Decode(&last)
+	if err != nil {
+		return err
+	}
+
+	to := time.Unix(last.TimeIndex, 0).UTC()
+
+	for month := time.Unix(first.TimeIndex, 0).UTC(); !month.After(to); month = month.AddDate(0, 1, 0) {
+		if err := i.partitions.Ensure(month.Unix()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import copies the collections into the postgres tables. The rows overwrite
+// the existing ones, an interrupted import is run again from the start.
+func (i *MongoImporter) Import() ([]*MongoImportResult, error) {
+	ctx := context.Background()
+
+	if err := i.checkTarget(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := i.ensurePartitions(ctx); err != nil {
+		return nil, fmt.Errorf("ensure partitions failed: %w", err)
+	}
+
+	var results []*MongoImportResult
+
+	for _, source := range i.sources() {
+		if source.copy == nil {
+			continue
+		}
+
+		start := time.Now()
+
+		cursor, err := i.aggregate(ctx, source.collection, source.pipeline)
+		if err != nil {
+			return results, fmt.Errorf("%s: read failed: %w", source.name, err)
+		}
+
+		count, err := source.copy(ctx, cursor)
+		cursor.Close(ctx)
+
+		if err != nil {
+			return results, fmt.Errorf("%s: import failed after %d rows: %w", source.name, count, err)
+		}
+
+		i.log.Infof("%s: %d rows imported in %v", source.name, count, time.Since(start))
+		results = append(results, &MongoImportResult{Name: source.name, Rows: count, Duration: time.Since(start)})
+	}
+
+	return results, nil
+}
+
+// totalsPipeline counts the documents of the source and sums their field
+func (source *mongoImportSource) totalsPipeline() mongo.Pipeline {
+	total := bson.D{{Key: "$sum", Value: 0}}
+	if source.field != "" {
+		total = bson.D{{Key: "$sum", Value: "$" + source.field}}
+	}
+
+	return append(slices.Clone(source.pipeline), bson.D{{Key: "$group", Value: bson.D{
+		{Key: "_id", Value: nil},
+		{Key: "rows", Value: bson.D{{Key: "$sum", Value: 1}}},
+		{Key: "total", Value: total},
+	}}})
+}
+
+type importTotals struct {
+	RowCount int64
+	Total    int64
+}
+
+// tableTotals counts the rows of the table of the source and sums its column
+func (source *mongoImportSource) tableTotals(db *gorm.DB) (*importTotals, error) {
+	sum := "0"
+	if source.column != "" {
+		sum = fmt.Sprintf("COALESCE(SUM(%s), 0)", source.column)
+	}
+
+	totals := &importTotals{}
+
+	err := db.Model(source.table).
+		Select("COUNT(*) AS row_count, " + sum + " AS total").
+		Scan(totals).Error
+
+	return totals, err
+}
+
+// Validate compares the row count and the amounts of each collection with its
+// table
+func (i *MongoImporter) Validate() ([]*MongoImportCheck, error) {
+	ctx := context.Background()
+
+	var checks []*MongoImportCheck
+
+	for _, source := range i.sources() {
+		check := &MongoImportCheck{Name: source.name}
+
+		cursor, err := i.aggregate(ctx, source.collection, source.totalsPipeline())
+		if err != nil {
+			return checks, fmt.Errorf("%s: read failed: %w", source.name, err)
+		}
+
+		var mongoTotals []struct {
+			Rows  int64 `bson:"rows"`
+			Total int64 `bson:"total"`
+		}
+
+		err = cursor.All(ctx, &mongoTotals)
+		if err != nil {
+			return checks, fmt.Errorf("%s: read failed: %w", source.name, err)
+		}
+
+		if len(mongoTotals) > 0 {
+			check.MongoRows = mongoTotals[0].Rows
+			check.MongoTotal = mongoTotals[0].Total
+		}
+
+		pgTotals, err := source.tableTotals(i.db.WithContext(ctx))
+		if err != nil {
+			return checks, fmt.Errorf("%s: read failed: %w", source.name, err)
+		}
+
+		check.PgRows = pgTotals.RowCount
+		check.PgTotal = pgTotals.Total
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestMongoImportCheckPassed(t *testing.T) {
+	for _, c := range []struct {
+		check MongoImportCheck
+		want  bool
+	}{
+		{MongoImportCheck{MongoRows: 3, PgRows: 3, MongoTotal: 60, PgTotal: 60}, true},
+		{MongoImportCheck{MongoRows: 3, PgRows: 2, MongoTotal: 60, PgTotal: 60}, false},
+		{MongoImportCheck{MongoRows: 3, PgRows: 3, MongoTotal: 60, PgTotal: 50}, false},
+	} {
+		if got := c.check.Passed(); got != c.want {
+			t.Errorf("%+v passed = %v, want %v", c.check, got, c.want)
+		}
+	}
+}
+
+func TestTotalsPipeline(t *testing.T) {
+	pipeline := make(mongo.Pipeline, 0, 4)
+	pipeline = append(pipeline, sumPipeline([]string{"address"}, "balance")...)
+
+	source := &mongoImportSource{pipeline: pipeline, field: "balance"}
+
+	got := source.totalsPipeline()
+
+	want := append(sumPipeline([]string{"address"}, "balance"), bson.D{{Key: "$group", Value: bson.D{
+		{Key: "_id", Value: nil},
+		{Key: "rows", Value: bson.D{{Key: "$sum", Value: 1}}},
+		{Key: "total", Value: bson.D{{Key: "$sum", Value: "$balance"}}},
+	}}})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("totalsPipeline = %v, want %v", got, want)
+	}
+
+	// the stage is appended to a copy, the source keeps the pipeline that
+	// copies the documents
+	source.pipeline = append(source.pipeline, bson.D{{Key: "$limit", Value: 1}})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("totalsPipeline shares its stages with the source: %v", got)
+	}
+
+	count := (&mongoImportSource{}).totalsPipeline()
+	if len(count) != 1 || !reflect.DeepEqual(count[0][0].Value.(bson.D)[2].Value, bson.D{{Key: "$sum", Value: 0}}) {
+		t.Errorf("totalsPipeline without field = %v", count)
+	}
+}
+
+func TestTableTotals(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.AutoMigrate(&model.AccountBalance{}); err != nil {
+		t.Fatal(err)
+	}
+
+	source := &mongoImportSource{table: &model.AccountBalance{}, column: "balance"}
+
+	totals, err := source.tableTotals(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *totals != (importTotals{}) {
+		t.Errorf("totals of an empty table = %+v", *totals)
+	}
+
+	rows := []*model.AccountBalance{{Address: "a", Balance: 10}, {Address: "b", Balance: 20}, {Address: "c", Balance: 30}}
+	if err := db.Create(rows).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	totals, err = source.tableTotals(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *totals != (importTotals{RowCount: 3, Total: 60}) {
+		t.Errorf("totals = %+v, want 3 rows of 60", *totals)
+	}
+
+	// a source without column only compares the row count
+	totals, err = (&mongoImportSource{table: &model.AccountBalance{}}).tableTotals(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *totals != (importTotals{RowCount: 3}) {
+		t.Errorf("totals without column = %+v, want 3 rows", *totals)
+	}
+}
+
+// TestImportOrder checks the tables chainscan resumes from are imported last
+func TestImportOrder(t *testing.T) {
+	var copied []string
+	for _, source := range (&MongoImporter{mongo: NewDBClient()}).sources() {
+		if source.copy != nil {
+			copied = append(copied, source.name)
+		}
+	}
+
+	if len(copied) < 2 || copied[len(copied)-2] != "block" || copied[len(copied)-1] != "checkpoint" {
+		t.Errorf("import order %v does not end with block and checkpoint", copied)
+	}
+}
@@ -1,39 +1,85 @@
 package store
 
 import (
-	_ "embed"
+	"embed"
+	"io/fs"
 	"time"
 
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/log"
 	"github.com/1pactus/1pactus-react/store/storedriver"
 )
 
 const (
 	POSTGRES_DB_TIMEOUT = 10 * time.Second
+	POSTGRES_BATCH_SIZE = 1000
+	// row count from which commit rows are staged with COPY rather than
+	// inserted in batches
+	POSTGRES_COPY_MIN_ROWS = 5000
 )
 
 type postgresStore struct {
-	db storedriver.GormPostgres
+	db      storedriver.GormPostgres
+	rules   constants.SupplyRules
+	dialect sqlDialect
+	// nil on the sqlite backend, which has no partitions
+	partitions *storedriver.PostgresPartitioner
 }
 
 func (s *postgresStore) Init(db storedriver.GormPostgres) {
 	s.db = db
+	s.dialect = dialectOf(db.GetDB())
+
+	if _, ok := s.dialect.(postgresDialect); ok {
+		s.partitions = storedriver.NewPostgresPartitioner(db.GetDB(), s.Tables(), log.WithKv("module", "store").WithKv("partition", "postgres"))
+	}
 }
 
-func (s *postgresStore) AutoMigrate() error {
-	return s.db.GetDB().AutoMigrate(
-		&model.GlobalState{},
-		&model.Block{},
-	)
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+func (s *postgresStore) Migrations() fs.FS {
+	migrations, _ := fs.Sub(migrationFiles, "migrations")
+	return migrations
 }
 
+func (s *postgresStore) Seed() error {
+	return s.initGenesisBalance()
+}
+
+// Models lists the models of the tables created by the migrations, a model
+// change needs a migration. The sqlite backend creates its tables from them.
 func (s *postgresStore) Models() []interface{} {
 	return []interface{}{
 		&model.GlobalState{},
 		&model.Block{},
+		&model.AccountBalance{},
+		&model.AccountBalanceTimeIndex{},
+		&model.ValidatorState{},
+		&model.ValidatorStakeTimeIndex{},
+		&model.TxTransferTimeIndex{},
+		&model.TxRewardTimeIndex{},
+		&model.TxBondTimeIndex{},
+		&model.TxUnbondTimeIndex{},
+		&model.TxWithdrawTimeIndex{},
+		&model.ValidatorUnbond{},
+		&model.ValidatorStatTimeIndex{},
+		&model.ValidatorRewardTimeIndex{},
+		&model.PayloadStatTimeIndex{},
+		&model.AuditRun{},
+		&model.AuditRecord{},
+		&model.WealthDistributionTimeIndex{},
+		&model.WealthBucketTimeIndex{},
+		&model.AccountActivity{},
+		&model.AccountActiveTimeIndex{},
+		&model.AccountActivityTimeIndex{},
+		&model.ActiveSketchTimeIndex{},
+		&model.ValidatorEvent{},
+		&model.ValidatorLifecycle{},
+		&model.ValidatorChurnTimeIndex{},
+		&model.BlockTimingTimeIndex{},
+		&model.BlockRoundTimeIndex{},
+		&model.Checkpoint{},
 	}
 }
-
-func (s *postgresStore) Indexes() []storedriver.IndexSchema {
-	return []storedriver.IndexSchema{}
-}
@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// weekOf is the sql counterpart of model.GetWeekTimeIndex
+func weekOf(column string) string {
+	return fmt.Sprintf("%s - ((%s / %d + 3) %% 7) * %d", column, column, model.TimeIndexInterval, model.TimeIndexInterval)
+}
+
+// updateAccountActivity classifies the active accounts of the committed day by
+// their history, then extends that history with the day.
+func (c *postgresStore) updateAccountActivity(tx *gorm.DB, commitContext PgCommitContext) error {
+	timeIndex := commitContext.GetTimeIndex()
+	actives := commitContext.GetGlobalState().ActiveAccounts
+
+	stat := &model.AccountActivityTimeIndex{TimeIndex: timeIndex, Active: int64(len(actives))}
+
+	dormantBefore := timeIndex - model.AccountDormantDays*model.TimeIndexInterval
+
+	for chunk := range slices.Chunk(actives, POSTGRES_BATCH_SIZE) {
+		var previous []*model.AccountActivity
+
+		if err := tx.Where("address IN ? AND first_time_index < ?", chunk, timeIndex).Find(&previous).Error; err != nil {
+			return err
+		}
+
+		stat.Returning += int64(len(previous))
+
+		for _, p := range previous {
+			if p.LastTimeIndex < dormantBefore {
+				stat.Reactivated++
+			}
+		}
+	}
+
+	stat.New = stat.Active - stat.Returning
+
+	if len(actives) > 0 {
+		activities := make([]*model.AccountActivity, 0, len(actives))
+		activeRows := make([]*model.AccountActiveTimeIndex, 0, len(actives))
+
+		for _, address := range actives {
+			activities = append(activities, &model.AccountActivity{
+				Address:        address,
+				FirstTimeIndex: timeIndex,
+				LastTimeIndex:  timeIndex,
+				ActiveDays:     1,
+			})
+			activeRows = append(activeRows, &model.AccountActiveTimeIndex{Address: address, TimeIndex: timeIndex})
+		}
+
+		current := func(name string) clause.Column {
+			return clause.Column{Table: clause.CurrentTable, Name: name}
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "address"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"first_time_index": gorm.Expr(c.dialect.least("?", "excluded.first_time_index"), current("first_time_index")),
+				"last_time_index":  gorm.Expr(c.dialect.greatest("?", "excluded.last_time_index"), current("last_time_index")),
+				"active_days": gorm.Expr("? + CASE WHEN ? < excluded.last_time_index THEN 1 ELSE 0 END",
+					current("active_days"), current("last_time_index")),
+			}),
+		}).CreateInBatches(activities, POSTGRES_BATCH_SIZE).Error
+
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).
+			CreateInBatches(activeRows, POSTGRES_BATCH_SIZE).Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).Create(stat).Error
+}
+
+func (s *postgresStore) GetAccountActivity(days int64) ([]*model.AccountActivityTimeIndex, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var rets []*model.AccountActivityTimeIndex
+
+	err := s.db.GetReadDB().WithContext(ctx).
+		Where("time_index >= ?", sinceTimeIndex(days)).
+		Order("time_index").
+		Find(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetAccountRetention(weeks int64) ([]*model.AccountCohort, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := model.GetWeekTimeIndex(model.GetTimeIndex(time.Now().Unix())) - (weeks-1)*model.TimeIndexWeekInterval
+
+	var rows []struct {
+		Cohort     int64
+		ActiveWeek int64
+		Accounts   int64
+	}
+
+	err := db.Table("(?) AS cohorts", db.Model(&model.AccountActivity{}).
+		Select("address, "+weekOf("first_time_index")+" AS cohort").
+		Where(weekOf("first_time_index")+" >= ?", since)).
+		Joins("JOIN (?) AS actives ON actives.address = cohorts.address", db.Model(&model.AccountActiveTimeIndex{}).
+			Select("address, "+weekOf("time_index")+" AS active_week").
+			Where("time_index >= ?", since)).
+		Select("cohort, active_week, COUNT(DISTINCT actives.address) AS accounts").
+		Group("cohort, active_week").
+		Order("cohort, active_week").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	rets := make([]*model.AccountCohort, 0)
+	cohorts := make(map[int64]*model.AccountCohort)
+
+	for _, row := range rows {
+		cohort, ok := cohorts[row.Cohort]
+		if !ok {
+			cohort = &model.AccountCohort{Week: row.Cohort}
+			cohorts[row.Cohort] = cohort
+			rets = append(rets, cohort)
+		}
+
+		offset := int((row.ActiveWeek - row.Cohort) / model.TimeIndexWeekInterval)
+		for len(cohort.Retained) <= offset {
+			cohort.Retained = append(cohort.Retained, 0)
+		}
+
+		cohort.Retained[offset] = row.Accounts
+	}
+
+	for _, cohort := range rets {
+		if len(cohort.Retained) > 0 {
+			cohort.Size = cohort.Retained[0]
+		}
+	}
+
+	return rets, nil
+}
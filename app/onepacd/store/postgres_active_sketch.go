@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (c *postgresStore) updateActiveSketch(tx *gorm.DB, commitContext PgCommitContext) error {
+	globalState := commitContext.GetGlobalState()
+
+	sketch, err := model.NewActiveSketchTimeIndex(commitContext.GetTimeIndex(), globalState.ActiveAccounts, globalState.ActiveValidators)
+	if err != nil {
+		return err
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).Create(sketch).Error
+}
+
+// GetDistinctActive estimates the distinct active accounts and validators
+// between two timeindexes, both included
+func (s *postgresStore) GetDistinctActive(from int64, to int64) (*model.DistinctActive, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var sketches []*model.ActiveSketchTimeIndex
+
+	err := s.db.GetReadDB().WithContext(ctx).
+		Where("time_index >= ? AND time_index <= ?", from, to).
+		Find(&sketches).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return model.MergeActiveSketches(from, to, sketches)
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (c *postgresStore) updateTransfers(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().TransferTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, incrementOnConflict([]string{"address_from", "address_to", "time_index"}, "amount"))
+}
+
+func (c *postgresStore) updateRewardTransfers(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().RewardTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, incrementOnConflict([]string{"address", "time_index"}, "amount"))
+}
+
+func (c *postgresStore) updateBonds(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().BondTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, incrementOnConflict([]string{"address_from", "address_to", "time_index"}, "amount"))
+}
+
+func (c *postgresStore) updateUnbondTransfers(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().UnbondTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, clause.OnConflict{DoNothing: true})
+}
+
+func (c *postgresStore) updateWithdraws(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().WithdrawTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, incrementOnConflict([]string{"address_from", "address_to", "time_index"}, "amount"))
+}
+
+type timeIndexAmount struct {
+	TimeIndex int64
+	Amount    int64
+}
+
+func sumAmountByTimeIndex(db *gorm.DB, table interface{}, addressColumn string, address string, since int64) ([]timeIndexAmount, error) {
+	var rets []timeIndexAmount
+
+	err := db.Model(table).
+		Select("time_index, SUM(amount) AS amount").
+		Where(clause.Eq{Column: clause.Column{Name: addressColumn}, Value: address}).
+		Where("time_index >= ?", since).
+		Group("time_index").
+		Scan(&rets).Error
+
+	return rets, err
+}
+
+func (s *postgresStore) GetAddressFlows(address string, days int64) ([]*model.AddressFlow, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := sinceTimeIndex(days)
+
+	flows := make(map[int64]*model.AddressFlow)
+	flowOf := func(timeIndex int64) *model.AddressFlow {
+		if flow, ok := flows[timeIndex]; ok {
+			return flow
+		}
+		flow := &model.AddressFlow{TimeIndex: timeIndex}
+		flows[timeIndex] = flow
+		return flow
+	}
+
+	sources := []struct {
+		table         interface{}
+		addressColumn string
+		apply         func(flow *model.AddressFlow, amount int64)
+	}{
+		{&model.TxTransferTimeIndex{}, "address_to", func(f *model.AddressFlow, v int64) { f.TransferIn += v }},
+		{&model.TxTransferTimeIndex{}, "address_from", func(f *model.AddressFlow, v int64) { f.TransferOut += v }},
+		{&model.TxRewardTimeIndex{}, "address", func(f *model.AddressFlow, v int64) { f.Reward += v }},
+		{&model.TxBondTimeIndex{}, "address_to", func(f *model.AddressFlow, v int64) { f.BondIn += v }},
+		{&model.TxBondTimeIndex{}, "address_from", func(f *model.AddressFlow, v int64) { f.BondOut += v }},
+		{&model.TxWithdrawTimeIndex{}, "address_to", func(f *model.AddressFlow, v int64) { f.WithdrawIn += v }},
+		{&model.TxWithdrawTimeIndex{}, "address_from", func(f *model.AddressFlow, v int64) { f.WithdrawOut += v }},
+	}
+
+	for _, source := range sources {
+		amounts, err := sumAmountByTimeIndex(db, source.table, source.addressColumn, address, since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range amounts {
+			source.apply(flowOf(a.TimeIndex), a.Amount)
+		}
+	}
+
+	rets := make([]*model.AddressFlow, 0, len(flows))
+	for _, flow := range flows {
+		rets = append(rets, flow)
+	}
+
+	slices.SortFunc(rets, func(a, b *model.AddressFlow) int {
+		return cmp.Compare(a.TimeIndex, b.TimeIndex)
+	})
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetAddressCounterparties(address string, days int64, limit int) ([]*model.AddressCounterparty, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := sinceTimeIndex(days)
+
+	sent := db.Model(&model.TxTransferTimeIndex{}).
+		Select("address_to AS address, SUM(amount) AS sent, 0 AS received").
+		Where("address_from = ? AND time_index >= ?", address, since).
+		Group("address_to")
+
+	received := db.Model(&model.TxTransferTimeIndex{}).
+		Select("address_from AS address, 0 AS sent, SUM(amount) AS received").
+		Where("address_to = ? AND time_index >= ?", address, since).
+		Group("address_from")
+
+	var rets []*model.AddressCounterparty
+
+	err := db.Table("(? UNION ALL ?) AS counterparties", sent, received).
+		Select("address, SUM(sent) AS sent, SUM(received) AS received").
+		Group("address").
+		Order("SUM(sent) + SUM(received) DESC").
+		Limit(limit).
+		Scan(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
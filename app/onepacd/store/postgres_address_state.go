@@ -0,0 +1,114 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// incrementOnConflict builds an upsert clause that adds the excluded values of
+// incColumns to the existing row instead of overwriting them.
+func incrementOnConflict(conflictColumns []string, incColumns ...string) clause.OnConflict {
+	columns := make([]clause.Column, 0, len(conflictColumns))
+	for _, name := range conflictColumns {
+		columns = append(columns, clause.Column{Name: name})
+	}
+
+	assignments := make(map[string]interface{}, len(incColumns))
+	for _, name := range incColumns {
+		assignments[name] = gorm.Expr("? + excluded.?",
+			clause.Column{Table: clause.CurrentTable, Name: name}, clause.Column{Name: name})
+	}
+
+	return clause.OnConflict{
+		Columns:   columns,
+		DoUpdates: clause.Assignments(assignments),
+	}
+}
+
+// upsertRows writes the rows of a commit with onConflict. Large row sets are
+// staged with COPY and merged in one statement, which keeps a rescan from
+// genesis bound by the node rather than by the round trips to the database.
+func upsertRows[T any](tx *gorm.DB, rows []*T, onConflict clause.OnConflict) error {
+	if len(rows) >= POSTGRES_COPY_MIN_ROWS {
+		err := storedriver.CopyMerge(tx, rows, onConflict)
+		if !errors.Is(err, storedriver.ErrCopyUnavailable) {
+			return err
+		}
+	}
+
+	return tx.Clauses(onConflict).CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+func (s *postgresStore) initGenesisBalance() error {
+	genesis := s.rules.GenesisAccounts()
+
+	if len(genesis) == 0 {
+		return nil
+	}
+
+	rows := make([]*model.AccountBalance, 0, len(genesis))
+	for _, account := range genesis {
+		rows = append(rows, &model.AccountBalance{Address: account.Address, Balance: account.Balance})
+	}
+
+	return s.db.GetDB().Clauses(clause.OnConflict{DoNothing: true}).Create(rows).Error
+}
+
+func (c *postgresStore) updateAccountBalance(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().AccountBalances()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.
+		Clauses(incrementOnConflict([]string{"address"}, "balance")).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+func (c *postgresStore) updateAccountBalanceIndex(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().AccountBalanceTimeIndexes(commitContext.GetTimeIndex())
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return upsertRows(tx, rows, incrementOnConflict([]string{"address", "time_index"}, "balance_change"))
+}
+
+func (c *postgresStore) updateValidatorStake(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorStates()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// stake_max only grows with bonded stake, withdrawals never reduce it
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "address"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"stake": gorm.Expr("? + excluded.stake",
+					clause.Column{Table: clause.CurrentTable, Name: "stake"}),
+				"stake_max": gorm.Expr("? + "+c.dialect.greatest("excluded.stake", "0"),
+					clause.Column{Table: clause.CurrentTable, Name: "stake_max"}),
+			}),
+		}).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+func (c *postgresStore) updateValidatorStakeIndex(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorStakeTimeIndexes(commitContext.GetTimeIndex())
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.
+		Clauses(incrementOnConflict([]string{"address", "time_index"}, "stake_change")).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+)
+
+func (s *postgresStore) GetIndexedTotals() (*model.IndexedTotals, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetDB().WithContext(ctx)
+	totals := &model.IndexedTotals{}
+
+	if err := db.Model(&model.Block{}).Select("COALESCE(MAX(height), 0)").Scan(&totals.Height).Error; err != nil {
+		return nil, err
+	}
+
+	// the node reports no power for unbonded validators
+	err := db.Model(&model.ValidatorState{}).
+		Select("COALESCE(SUM(stake), 0)").
+		Where("address NOT IN (?)", db.Model(&model.ValidatorUnbond{}).Select("address")).
+		Scan(&totals.BondedStake).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Model(&model.ValidatorState{}).Count(&totals.Validators).Error; err != nil {
+		return nil, err
+	}
+
+	if err := db.Model(&model.AccountBalance{}).Count(&totals.Accounts).Error; err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (s *postgresStore) GetRandomAccountBalances(limit int) ([]*model.AccountBalance, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var rets []*model.AccountBalance
+
+	if err := s.db.GetDB().WithContext(ctx).Order("RANDOM()").Limit(limit).Find(&rets).Error; err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetAccountBalances(addresses []string) ([]*model.AccountBalance, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var rets []*model.AccountBalance
+
+	if len(addresses) == 0 {
+		return rets, nil
+	}
+
+	if err := s.db.GetDB().WithContext(ctx).Where("address IN ?", addresses).Find(&rets).Error; err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetRandomValidatorStates(limit int) ([]*model.ValidatorState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var rets []*model.ValidatorState
+
+	if err := s.db.GetDB().WithContext(ctx).Order("RANDOM()").Limit(limit).Find(&rets).Error; err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) InsertAuditRun(run *model.AuditRun) error {
+	return s.db.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(run).Error; err != nil {
+			return err
+		}
+
+		if len(run.Records) == 0 {
+			return nil
+		}
+
+		for _, record := range run.Records {
+			record.RunID = run.ID
+		}
+
+		return tx.CreateInBatches(run.Records, POSTGRES_BATCH_SIZE).Error
+	})
+}
+
+func (s *postgresStore) GetLatestAuditRun() (*model.AuditRun, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetDB().WithContext(ctx)
+	run := &model.AuditRun{}
+
+	if err := db.Order("id DESC").First(run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := db.Where("run_id = ?", run.ID).Order("id").Find(&run.Records).Error; err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
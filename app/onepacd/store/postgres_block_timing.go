@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (c *postgresStore) updateBlockTiming(tx *gorm.DB, commitContext PgCommitContext) error {
+	timings := commitContext.GetTxMerger().BlockTimingTimeIndexes()
+
+	if len(timings) == 0 {
+		return nil
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).CreateInBatches(timings, POSTGRES_BATCH_SIZE).Error
+
+	if err != nil {
+		return err
+	}
+
+	rounds := commitContext.GetTxMerger().BlockRoundTimeIndexes()
+
+	if len(rounds) == 0 {
+		return nil
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}, {Name: "round"}},
+		UpdateAll: true,
+	}).CreateInBatches(rounds, POSTGRES_BATCH_SIZE).Error
+}
+
+func (s *postgresStore) GetBlockTiming(days int64) ([]*model.BlockTimingTimeIndex, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := sinceTimeIndex(days)
+
+	var rets []*model.BlockTimingTimeIndex
+
+	if err := db.Where("time_index >= ?", since).Order("time_index").Find(&rets).Error; err != nil {
+		return nil, err
+	}
+
+	var rounds []*model.BlockRoundTimeIndex
+
+	if err := db.Where("time_index >= ?", since).Order("time_index, round").Find(&rounds).Error; err != nil {
+		return nil, err
+	}
+
+	byTimeIndex := make(map[int64][]*model.BlockRoundTimeIndex)
+	for _, r := range rounds {
+		byTimeIndex[r.TimeIndex] = append(byTimeIndex[r.TimeIndex], r)
+	}
+
+	for _, t := range rets {
+		t.Rounds = byTimeIndex[t.TimeIndex]
+	}
+
+	return rets, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (s *postgresStore) GetTopGlobalState() (*model.GlobalState, error) {
@@ -20,7 +21,14 @@ func (s *postgresStore) GetTopGlobalState() (*model.GlobalState, error) {
 }
 
 func (s *postgresStore) InsertGlobalState(state *model.GlobalState) error {
-	return s.db.GetDB().Create(state).Error
+	return upsertGlobalState(s.db.GetDB(), state)
+}
+
+func upsertGlobalState(db *gorm.DB, state *model.GlobalState) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).Create(state).Error
 }
 
 func (s *postgresStore) GetTopBlock() (*model.Block, error) {
@@ -34,3 +42,27 @@ func (s *postgresStore) GetTopBlock() (*model.Block, error) {
 	}
 	return block, nil
 }
+
+func (s *postgresStore) GetCheckpoint(name string) (*model.Checkpoint, error) {
+	checkpoint := &model.Checkpoint{}
+	err := s.db.GetDB().Where("name = ?", name).First(checkpoint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func (s *postgresStore) GetValidatorStake(address string) (int64, error) {
+	state := &model.ValidatorState{}
+	err := s.db.GetDB().Where("address = ?", address).First(state).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return state.Stake, nil
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
 )
@@ -12,9 +13,36 @@ func (s *postgresStore) GetNetworkGlobalStats(count int64) ([]model.GlobalState,
 
 	var rets []model.GlobalState
 
-	if err := s.db.GetDB().WithContext(ctx).Order("time_index DESC").Limit(int(count)).Find(&rets).Error; err != nil {
+	db := s.db.GetReadDB().WithContext(ctx)
+
+	if err := db.Order("time_index DESC").Limit(int(count)).Find(&rets).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rets) == 0 {
+		return rets, nil
+	}
+
+	var payloads []*model.PayloadStatTimeIndex
+
+	if err := db.Where("time_index >= ?", rets[len(rets)-1].TimeIndex).Order("payload_type").Find(&payloads).Error; err != nil {
 		return nil, err
 	}
 
+	byTimeIndex := make(map[int64][]*model.PayloadStatTimeIndex)
+	for _, p := range payloads {
+		byTimeIndex[p.TimeIndex] = append(byTimeIndex[p.TimeIndex], p)
+	}
+
+	for i := range rets {
+		rets[i].Payloads = byTimeIndex[rets[i].TimeIndex]
+	}
+
 	return rets, nil
 }
+
+// sinceTimeIndex returns the time index of the first day in a window of the
+// last days days, today included.
+func sinceTimeIndex(days int64) int64 {
+	return model.GetTimeIndex(time.Now().Unix()) - (days-1)*model.TimeIndexInterval
+}
@@ -0,0 +1,23 @@
+package store
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updatePayloadStats overwrites the statistics of a day on conflict, since fee
+// percentiles of a partially committed day cannot be merged.
+func (c *postgresStore) updatePayloadStats(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().PayloadStatTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "time_index"}, {Name: "payload_type"}},
+			UpdateAll: true,
+		}).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
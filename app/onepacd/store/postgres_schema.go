@@ -0,0 +1,74 @@
+package store
+
+import (
+	"github.com/1pactus/1pactus-react/store/storedriver"
+)
+
+// monthlyPartitioned are the per address history tables, range partitioned by
+// month of time_index by migration 0002
+var monthlyPartitioned = []string{
+	"account_balance_time_indices",
+	"tx_transfer_time_indices",
+	"tx_reward_time_indices",
+	"tx_bond_time_indices",
+	"tx_unbond_time_indices",
+	"tx_withdraw_time_indices",
+}
+
+// Tables lists the tables whose layout the driver checks and maintains beyond
+// the migrations
+func (s *postgresStore) Tables() []storedriver.TableSchema {
+	tables := make([]storedriver.TableSchema, 0, len(monthlyPartitioned))
+
+	for _, name := range monthlyPartitioned {
+		tables = append(tables, storedriver.TableSchema{
+			Name: name,
+			Partition: &storedriver.PartitionSchema{
+				Strategy: storedriver.PartitionByRange,
+				Columns:  []string{"time_index"},
+				Monthly:  true,
+				Premake:  1,
+			},
+		})
+	}
+
+	return tables
+}
+
+// Indexes lists the indexes tuned for the read queries, which the driver
+// builds concurrently at startup when missing. Indexes the queries of the
+// commit rely on belong to the models and the migrations.
+func (s *postgresStore) Indexes() []storedriver.IndexSchema {
+	return []storedriver.IndexSchema{
+		// rich list, answered from the index alone
+		{
+			Name:    "idx_account_balance_funded",
+			Table:   "account_balances",
+			Columns: []string{"balance DESC"},
+			Include: []string{"address"},
+			Where:   "balance > 0",
+		},
+		// unbond forecast, only unbonds not fully withdrawn are looked up
+		{
+			Name:    "idx_validator_unbond_pending",
+			Table:   "validator_unbonds",
+			Columns: []string{"mature_time_index"},
+			Include: []string{"stake", "withdrawn"},
+			Where:   "stake > withdrawn",
+		},
+		// live validators of the churn
+		{
+			Name:    "idx_validator_lifecycle_live",
+			Table:   "validator_lifecycles",
+			Columns: []string{"bond_time_index"},
+			Where:   "unbond_time_index = 0",
+		},
+		// cohorts of the account retention
+		{
+			Name:    "idx_account_activity_cohort",
+			Table:   "account_activities",
+			Columns: []string{weekOf("first_time_index")},
+			Include: []string{"address"},
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/config"
+)
+
+// newSqliteStore opens the store on a sqlite file of the test temp dir
+func newSqliteStore(t *testing.T) *postgresStore {
+	t.Helper()
+
+	rules, err := constants.GetSupplyRules(constants.SupplyRulesNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &postgresStore{rules: rules}
+	conf := &config.SqliteConfig{Dir: t.TempDir(), File: "test.db", BusyTimeout: 5000}
+
+	if err := setupSqlite("test", conf, s); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if db, err := s.db.GetDB().DB(); err == nil {
+			db.Close()
+		}
+	})
+
+	return s
+}
+
+// commitPeriod commits the blocks startHeight to height-1 of a day
+func commitPeriod(s *postgresStore, startHeight int64, height int64, timeIndex int64, txMerger *model.TxMerger, stake int64) error {
+	globalState := model.NewGlobalState()
+	globalState.Reset(timeIndex)
+	globalState.Blocks = height - startHeight
+	globalState.Stake = stake
+
+	return s.Commit(NewPgDBCommitContext(startHeight, height, 0, timeIndex, txMerger, globalState.CreateCommitCopied()))
+}
+
+func TestSqliteCommit(t *testing.T) {
+	s := newSqliteStore(t)
+
+	// the two days before today, so they fall within the queried days
+	day1 := model.GetTimeIndex(time.Now().Unix()) - 2*model.TimeIndexInterval
+	day2 := day1 + model.TimeIndexInterval
+
+	first := model.NewTxMerger()
+	first.AddReward(day1, "alice", 1000, "validator")
+	first.AddTransfer(day1, "alice", "bob", 100, 1)
+
+	if err := commitPeriod(s, 1, 4, day1, first, 0); err != nil {
+		t.Fatalf("commit day 1: %v", err)
+	}
+
+	second := model.NewTxMerger()
+	second.AddBond(day2, "alice", "validator", 500, 1)
+	second.AddValidatorEvent(day2, "validator", model.ValidatorEventBond, 5, "bond", day2, 500, "alice")
+
+	if err := commitPeriod(s, 4, 7, day2, second, 500); err != nil {
+		t.Fatalf("commit day 2: %v", err)
+	}
+
+	// a replayed day is skipped, a day leaving a gap is refused
+	if err := commitPeriod(s, 1, 4, day1, first, 0); err != nil {
+		t.Fatalf("replay day 1: %v", err)
+	}
+
+	if err := commitPeriod(s, 10, 13, day2+model.TimeIndexInterval, model.NewTxMerger(), 500); err == nil {
+		t.Fatal("a day after a gap was committed")
+	}
+
+	checkpoint, err := s.GetCheckpoint(model.CheckpointChainscan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if checkpoint == nil || checkpoint.Height != 6 || checkpoint.TimeIndex != day2 {
+		t.Errorf("checkpoint = %+v, want height 6 on day 2", checkpoint)
+	}
+
+	state, err := s.GetTopGlobalState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if state == nil || state.TimeIndex != day2 || state.Stake != 500 || state.Blocks != 3 {
+		t.Errorf("top global state = %+v", state)
+	}
+
+	balances, err := s.GetAccountBalances([]string{"alice", "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int64{"alice": 1000 - 101 - 501, "bob": 100}
+	if len(balances) != len(want) {
+		t.Fatalf("got %d balances, want %d", len(balances), len(want))
+	}
+
+	for _, balance := range balances {
+		if balance.Balance != want[balance.Address] {
+			t.Errorf("%s balance = %d, want %d", balance.Address, balance.Balance, want[balance.Address])
+		}
+	}
+
+	stake, err := s.GetValidatorStake("validator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stake != 500 {
+		t.Errorf("validator stake = %d, want 500", stake)
+	}
+
+	flows, err := s.GetAddressFlows("alice", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(flows) != 2 {
+		t.Fatalf("got %d flows, want 2", len(flows))
+	}
+
+	if flows[0].TimeIndex != day1 || flows[0].Reward != 1000 || flows[0].TransferOut != 100 {
+		t.Errorf("day 1 flow = %+v", *flows[0])
+	}
+
+	if flows[1].TimeIndex != day2 || flows[1].BondOut != 500 {
+		t.Errorf("day 2 flow = %+v", *flows[1])
+	}
+
+	richList, err := s.GetRichList(10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(richList) != 2 || richList[0].Address != "alice" || richList[1].Address != "bob" {
+		t.Errorf("rich list = %+v", richList)
+	}
+
+	lifecycle, events, err := s.GetValidatorTimeline("validator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lifecycle == nil || lifecycle.BondTimeIndex != day2 || len(events) != 1 {
+		t.Errorf("timeline = %+v, %d events", lifecycle, len(events))
+	}
+}
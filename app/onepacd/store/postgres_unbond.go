@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updateValidatorUnbonds records new unbonds, with the validator stake the
+// scanner saw at unbond time. Replaying a period keeps the rows in place.
+func (c *postgresStore) updateValidatorUnbonds(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorUnbonds()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	for _, row := range rows {
+		row.SetMaturity(c.rules)
+	}
+
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+// updateValidatorWithdrawn adds the withdrawals to the latest unbond of each
+// validator
+func (c *postgresStore) updateValidatorWithdrawn(tx *gorm.DB, commitContext PgCommitContext) error {
+	withdrawn := commitContext.GetTxMerger().ValidatorWithdrawn()
+
+	if len(withdrawn) == 0 {
+		return nil
+	}
+
+	for address, amount := range withdrawn {
+		err := tx.Model(&model.ValidatorUnbond{}).
+			Where("address = ? AND height = (?)", address, tx.Model(&model.ValidatorUnbond{}).
+				Select("MAX(height)").
+				Where("address = ?", address)).
+			Updates(map[string]interface{}{
+				"withdrawn":            gorm.Expr("withdrawn + ?", amount),
+				"withdrawn_time_index": commitContext.GetTimeIndex(),
+			}).Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *postgresStore) GetUnbondForecast(days int64) (*model.UnbondForecast, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	today := model.GetTimeIndex(time.Now().Unix())
+
+	forecast := &model.UnbondForecast{}
+
+	err := db.Model(&model.ValidatorUnbond{}).
+		Select("COALESCE(SUM(stake - withdrawn), 0)").
+		Where("mature_time_index <= ? AND stake > withdrawn", today).
+		Scan(&forecast.Withdrawable).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Model(&model.ValidatorUnbond{}).
+		Select("mature_time_index AS time_index, SUM(stake - withdrawn) AS amount, COUNT(*) AS validators").
+		Where("mature_time_index > ? AND mature_time_index <= ? AND stake > withdrawn", today, today+days*model.TimeIndexInterval).
+		Group("mature_time_index").
+		Order("mature_time_index").
+		Scan(&forecast.Days).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return forecast, nil
+}
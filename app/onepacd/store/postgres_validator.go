@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+)
+
+var validatorLeaderboardOrders = map[string]string{
+	"blocks":     "blocks_proposed DESC",
+	"reward":     "reward DESC",
+	"stake":      "stake DESC",
+	"sortitions": "sortitions DESC",
+	"yield":      "CAST(reward AS DOUBLE PRECISION) / NULLIF(stake, 0) DESC NULLS LAST",
+}
+
+var validatorUptimeOrders = map[string]string{
+	"uptime":   "uptime DESC, certs_signed DESC",
+	"downtime": "uptime ASC, certs_missed DESC",
+}
+
+func (c *postgresStore) updateValidatorStats(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorStatTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.
+		Clauses(incrementOnConflict([]string{"address", "time_index"}, "blocks_proposed", "reward", "sortitions", "certs_signed", "certs_missed")).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+func (c *postgresStore) updateValidatorRewards(tx *gorm.DB, commitContext PgCommitContext) error {
+	rows := commitContext.GetTxMerger().ValidatorRewardTimeIndexes()
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.
+		Clauses(incrementOnConflict([]string{"address", "time_index", "receiver"}, "amount")).
+		CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+}
+
+// updateValidatorStatStake snapshots the bonded stake of every validator active
+// in the committed period. It must run after updateValidatorStake.
+func (c *postgresStore) updateValidatorStatStake(tx *gorm.DB, commitContext PgCommitContext) error {
+	return tx.Model(&model.ValidatorStatTimeIndex{}).
+		Where("time_index = ?", commitContext.GetTimeIndex()).
+		Update("stake", gorm.Expr("COALESCE((?), 0)", tx.Model(&model.ValidatorState{}).
+			Select("stake").
+			Where("validator_states.address = validator_stat_time_indices.address"))).Error
+}
+
+func (s *postgresStore) GetValidatorLeaderboard(days int64, limit int, orderBy string) ([]*model.ValidatorStat, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	order, ok := validatorLeaderboardOrders[orderBy]
+	if !ok {
+		order = validatorLeaderboardOrders["blocks"]
+	}
+
+	var rets []*model.ValidatorStat
+
+	// the stake is averaged over the daily snapshots of the window, so the
+	// yield relates the reward to the stake that earned it
+	err := s.db.GetReadDB().WithContext(ctx).
+		Table("(?) AS stats", s.db.GetReadDB().Model(&model.ValidatorStatTimeIndex{}).
+			Select("address, SUM(blocks_proposed) AS blocks_proposed, SUM(reward) AS reward, SUM(sortitions) AS sortitions, SUM(certs_signed) AS certs_signed, SUM(certs_missed) AS certs_missed, CAST(AVG(stake) AS BIGINT) AS stake").
+			Where("time_index >= ?", sinceTimeIndex(days)).
+			Group("address")).
+		Order(order).
+		Limit(limit).
+		Scan(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stat := range rets {
+		stat.Yield = model.AnnualisedYield(stat.Reward, stat.Stake, days)
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetValidatorUptime(days int64, limit int, orderBy string) ([]*model.ValidatorUptime, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	order, ok := validatorUptimeOrders[orderBy]
+	if !ok {
+		order = validatorUptimeOrders["uptime"]
+	}
+
+	var rets []*model.ValidatorUptime
+
+	err := s.db.GetReadDB().WithContext(ctx).
+		Table("(?) AS uptimes", s.db.GetReadDB().Model(&model.ValidatorStatTimeIndex{}).
+			Select("address, SUM(certs_signed) AS certs_signed, SUM(certs_missed) AS certs_missed, "+
+				"CAST(SUM(certs_signed) AS DOUBLE PRECISION) / SUM(certs_signed + certs_missed) AS uptime").
+			Where("time_index >= ?", sinceTimeIndex(days)).
+			Group("address").
+			Having("SUM(certs_signed + certs_missed) > 0")).
+		Order(order).
+		Limit(limit).
+		Scan(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetValidatorHistory(address string, days int64) ([]*model.ValidatorStat, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := sinceTimeIndex(days)
+
+	var rets []*model.ValidatorStat
+
+	err := db.Model(&model.ValidatorStatTimeIndex{}).
+		Where("address = ? AND time_index >= ?", address, since).
+		Order("time_index").
+		Scan(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rewards []model.ValidatorRewardTimeIndex
+
+	err = db.Where("address = ? AND time_index >= ?", address, since).
+		Order("time_index, amount DESC").
+		Find(&rewards).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	receivers := make(map[int64][]string)
+	for _, r := range rewards {
+		receivers[r.TimeIndex] = append(receivers[r.TimeIndex], r.Receiver)
+	}
+
+	for _, stat := range rets {
+		stat.RewardReceivers = receivers[stat.TimeIndex]
+		stat.Yield = model.AnnualisedYield(stat.Reward, stat.Stake, 1)
+	}
+
+	return rets, nil
+}
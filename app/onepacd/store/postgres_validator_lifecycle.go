@@ -0,0 +1,143 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updateValidatorLifecycle records the validator events of the committed
+// period, advances the lifecycles they belong to and snapshots the churn
+func (c *postgresStore) updateValidatorLifecycle(tx *gorm.DB, commitContext PgCommitContext) error {
+	timeIndex := commitContext.GetTimeIndex()
+	events := commitContext.GetTxMerger().ValidatorEvents()
+
+	addresses := make([]string, 0, len(events))
+	for _, e := range events {
+		if !slices.Contains(addresses, e.Address) {
+			addresses = append(addresses, e.Address)
+		}
+	}
+
+	lifecycles := make(map[string]*model.ValidatorLifecycle, len(addresses))
+
+	for chunk := range slices.Chunk(addresses, POSTGRES_BATCH_SIZE) {
+		var rows []*model.ValidatorLifecycle
+
+		if err := tx.Where("address IN ?", chunk).Find(&rows).Error; err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			lifecycles[row.Address] = row
+		}
+	}
+
+	// updateValidatorStake has committed the stakes of the period
+	stakes := make(map[string]int64)
+	withdrawers := commitContext.GetTxMerger().ValidatorWithdrawn()
+
+	for chunk := range slices.Chunk(slices.Collect(maps.Keys(withdrawers)), POSTGRES_BATCH_SIZE) {
+		var rows []*model.ValidatorState
+
+		if err := tx.Where("address IN ?", chunk).Find(&rows).Error; err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			stakes[row.Address] = row.Stake
+		}
+	}
+
+	churn := model.ApplyValidatorEvents(timeIndex, events, lifecycles, stakes)
+
+	if len(events) > 0 {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "hash"}},
+			UpdateAll: true,
+		}).CreateInBatches(events, POSTGRES_BATCH_SIZE).Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(lifecycles) > 0 {
+		rows := make([]*model.ValidatorLifecycle, 0, len(lifecycles))
+		for _, lifecycle := range lifecycles {
+			rows = append(rows, lifecycle)
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}},
+			UpdateAll: true,
+		}).CreateInBatches(rows, POSTGRES_BATCH_SIZE).Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tx.Model(&model.ValidatorLifecycle{}).
+		Where("bond_time_index <= ?", timeIndex).
+		Where("unbond_time_index = 0 OR unbond_time_index > ?", timeIndex).
+		Count(&churn.Live).Error
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).Create(churn).Error
+}
+
+func (s *postgresStore) GetValidatorChurn(days int64) ([]*model.ValidatorChurnTimeIndex, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var rets []*model.ValidatorChurnTimeIndex
+
+	err := s.db.GetReadDB().WithContext(ctx).
+		Where("time_index >= ?", sinceTimeIndex(days)).
+		Order("time_index").
+		Find(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rets, nil
+}
+
+// GetValidatorTimeline returns the lifecycle of a validator with its events
+// in chain order, or nil when no event of the validator was indexed
+func (s *postgresStore) GetValidatorTimeline(address string) (*model.ValidatorLifecycle, []*model.ValidatorEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+
+	lifecycle := &model.ValidatorLifecycle{}
+
+	if err := db.Where("address = ?", address).First(lifecycle).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var events []*model.ValidatorEvent
+
+	if err := db.Where("address = ?", address).Order("height").Find(&events).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return lifecycle, events, nil
+}
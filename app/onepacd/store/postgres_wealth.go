@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// blocks before the head of the chain within which the committed days get a
+	// wealth distribution snapshot, a year of blocks
+	POSTGRES_WEALTH_SNAPSHOT_BLOCKS = 365 * 8640
+)
+
+// fundedBalances scopes the account balances to funded accounts, without the
+// labelled reserve accounts when excludeReserve is set
+func (s *postgresStore) fundedBalances(db *gorm.DB, excludeReserve bool) *gorm.DB {
+	query := db.Model(&model.AccountBalance{}).Where("balance > 0")
+
+	if excludeReserve {
+		labels := s.rules.LabelledAccounts()
+
+		accounts := make([]string, 0, len(labels))
+		for account := range labels {
+			accounts = append(accounts, account)
+		}
+
+		if len(accounts) > 0 {
+			query = query.Where("address NOT IN ?", accounts)
+		}
+	}
+
+	return query
+}
+
+// updateWealthDistribution snapshots the balance distribution of the committed
+// day. It must run after updateAccountBalance. A snapshot reads every funded
+// balance, so the days older than POSTGRES_WEALTH_SNAPSHOT_BLOCKS are skipped
+// while the scan catches up.
+func (c *postgresStore) updateWealthDistribution(tx *gorm.DB, commitContext PgCommitContext) error {
+	if commitContext.GetLastBlockHeight()-commitContext.GetHeight() > POSTGRES_WEALTH_SNAPSHOT_BLOCKS {
+		return nil
+	}
+
+	for _, excludeReserve := range []bool{false, true} {
+		if err := c.snapshotWealthDistribution(tx, commitContext.GetTimeIndex(), excludeReserve); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *postgresStore) snapshotWealthDistribution(tx *gorm.DB, timeIndex int64, excludeReserve bool) error {
+	dist := &model.WealthDistributionTimeIndex{}
+
+	// gini over the balances sorted ascending: 2*sum(i*x_i)/(n*sum(x)) - (n+1)/n
+	err := tx.Table("(?) AS ranked", c.fundedBalances(tx, excludeReserve).
+		Select("balance, "+
+			"ROW_NUMBER() OVER (ORDER BY balance ASC) AS rank_asc, "+
+			"ROW_NUMBER() OVER (ORDER BY balance DESC) AS rank_desc")).
+		Select("COUNT(*) AS accounts, " +
+			"COALESCE(SUM(balance), 0) AS total, " +
+			"CAST(COALESCE(2.0 * SUM(" + c.dialect.numeric("rank_asc") + " * balance) / NULLIF(COUNT(*) * SUM(balance), 0) - " +
+			"(COUNT(*) + 1.0) / NULLIF(COUNT(*), 0), 0) AS DOUBLE PRECISION) AS gini, " +
+			"COALESCE(SUM(balance) FILTER (WHERE rank_desc <= 10), 0) AS top10, " +
+			"COALESCE(SUM(balance) FILTER (WHERE rank_desc <= 100), 0) AS top100, " +
+			"COALESCE(SUM(balance) FILTER (WHERE rank_desc <= 1000), 0) AS top1000").
+		Scan(dist).Error
+
+	if err != nil {
+		return err
+	}
+
+	dist.TimeIndex = timeIndex
+	dist.ExcludeReserve = excludeReserve
+
+	var buckets []*model.WealthBucketTimeIndex
+
+	err = tx.Table("(?) AS funded", c.fundedBalances(tx, excludeReserve).
+		Select("balance, "+c.dialect.exponent("balance")+" AS exponent")).
+		Select("exponent, COUNT(*) AS accounts, SUM(balance) AS balance").
+		Group("exponent").
+		Scan(&buckets).Error
+
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets {
+		bucket.TimeIndex = timeIndex
+		bucket.ExcludeReserve = excludeReserve
+	}
+
+	err = tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}, {Name: "exclude_reserve"}},
+		UpdateAll: true,
+	}).Create(dist).Error
+
+	if err != nil {
+		return err
+	}
+
+	err = tx.Where("time_index = ? AND exclude_reserve = ?", timeIndex, excludeReserve).
+		Delete(&model.WealthBucketTimeIndex{}).Error
+
+	if err != nil || len(buckets) == 0 {
+		return err
+	}
+
+	return tx.CreateInBatches(buckets, POSTGRES_BATCH_SIZE).Error
+}
+
+func (s *postgresStore) GetRichList(limit int, excludeReserve bool) ([]*model.RichListEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+
+	var total int64
+
+	if err := s.fundedBalances(db, excludeReserve).Select("COALESCE(SUM(balance), 0)").Scan(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var balances []*model.AccountBalance
+
+	if err := s.fundedBalances(db, excludeReserve).Order("balance DESC").Limit(limit).Find(&balances).Error; err != nil {
+		return nil, err
+	}
+
+	labels := s.rules.LabelledAccounts()
+	rets := make([]*model.RichListEntry, 0, len(balances))
+
+	for _, b := range balances {
+		entry := &model.RichListEntry{
+			Address: b.Address,
+			Balance: b.Balance,
+			Label:   labels[b.Address],
+		}
+
+		if total > 0 {
+			entry.Share = float64(b.Balance) / float64(total)
+		}
+
+		rets = append(rets, entry)
+	}
+
+	return rets, nil
+}
+
+func (s *postgresStore) GetWealthDistribution(days int64, excludeReserve bool) ([]*model.WealthDistributionTimeIndex, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	db := s.db.GetReadDB().WithContext(ctx)
+	since := sinceTimeIndex(days)
+
+	var rets []*model.WealthDistributionTimeIndex
+
+	err := db.Where("time_index >= ? AND exclude_reserve = ?", since, excludeReserve).
+		Order("time_index").
+		Find(&rets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []*model.WealthBucketTimeIndex
+
+	err = db.Where("time_index >= ? AND exclude_reserve = ?", since, excludeReserve).
+		Order("time_index, exponent").
+		Find(&buckets).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	byTimeIndex := make(map[int64][]*model.WealthBucketTimeIndex)
+	for _, b := range buckets {
+		byTimeIndex[b.TimeIndex] = append(byTimeIndex[b.TimeIndex], b)
+	}
+
+	for _, dist := range rets {
+		dist.Buckets = byTimeIndex[dist.TimeIndex]
+	}
+
+	return rets, nil
+}
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"context"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+)
+
+// GetStakingYield returns the daily network-wide staking yield of the last
+// days and the yield of the whole window on the average bonded stake
+func (s *postgresStore) GetStakingYield(days int64) (*model.StakingYield, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), POSTGRES_DB_TIMEOUT)
+	defer cancel()
+
+	var lines []*model.GlobalState
+
+	err := s.db.GetReadDB().WithContext(ctx).
+		Where("time_index >= ?", sinceTimeIndex(days)).
+		Order("time_index").
+		Find(&lines).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &model.StakingYield{
+		Days:  int64(len(lines)),
+		Lines: lines,
+	}
+
+	if len(lines) == 0 {
+		return ret, nil
+	}
+
+	var stake int64
+	for _, g := range lines {
+		ret.Reward += g.Reward
+		stake += g.Stake
+	}
+
+	ret.AvgStake = stake / ret.Days
+	ret.Yield = model.AnnualisedYield(ret.Reward, ret.AvgStake, ret.Days)
+
+	return ret, nil
+}
@@ -1,74 +1,165 @@
 package store
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"sync"
 
 	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+	"github.com/1pactus/1pactus-react/store/storedriver"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PgCommitContext interface {
 	GetTxMerger() *model.TxMerger
+	// GetStartHeight is the first block of the period, GetHeight the first
+	// block after it
+	GetStartHeight() int64
 	GetHeight() int64
+	// top block of the node when the scan started
+	GetLastBlockHeight() int64
 	GetTimeIndex() int64
 	GetGlobalState() *model.GlobalState
 }
 
+type pgCommitFunc struct {
+	name string
+	fn   func(tx *gorm.DB, commitContext PgCommitContext) error
+}
+
+// Commit writes a period in a single transaction together with the chainscan
+// checkpoint. A period at or before the checkpoint is already committed and is
+// skipped, replaying its additive updates would count it twice, and a period
+// not starting right after the checkpoint is refused, see periodCommitted. The
+// transaction is pinned to one connection so large row sets can be staged
+// with COPY, see upsertRows.
 func (c *postgresStore) Commit(commitContext PgCommitContext) error {
-	updateFuncs := []struct {
-		name string
-		fn   func(commitContext PgCommitContext) error
-	}{
+	updateFuncs := []pgCommitFunc{
 		{"insertBlockData", c.insertBlockData},
 		{"InsertGlobalState", c.insertGlobalState},
-		/* {"updateSenderTransfers", c.updateSenderTransfers},
-		{"updateReceiverTransfers", c.updateReceiverTransfers},
+		{"updateTransfers", c.updateTransfers},
 		{"updateRewardTransfers", c.updateRewardTransfers},
-		{"updateSenderBond", c.updateSenderBond},
-		{"updateReceiverBond", c.updateReceiverBond},
+		{"updateBonds", c.updateBonds},
 		{"updateUnbondTransfers", c.updateUnbondTransfers},
-		{"updateWithdrawSender", c.updateWithdrawSender},
-		{"updateWithdrawReceiver", c.updateWithdrawReceiver},
+		{"updateValidatorUnbonds", c.updateValidatorUnbonds},
+		{"updateWithdraws", c.updateWithdraws},
 		{"updateAccountBalance", c.updateAccountBalance},
 		{"updateAccountBalanceIndex", c.updateAccountBalanceIndex},
-		{"updateValidatorStake", c.updateValidatorStake},*/
+		{"updateValidatorStake", c.updateValidatorStake},
+		{"updateValidatorStakeIndex", c.updateValidatorStakeIndex},
+		{"updateValidatorStats", c.updateValidatorStats},
+		{"updateValidatorRewards", c.updateValidatorRewards},
+		{"updatePayloadStats", c.updatePayloadStats},
+		{"updateBlockTiming", c.updateBlockTiming},
+		{"updateAccountActivity", c.updateAccountActivity},
+		{"updateActiveSketch", c.updateActiveSketch},
+		{"updateValidatorLifecycle", c.updateValidatorLifecycle},
 	}
 
-	errChan := make(chan error, len(updateFuncs))
-	var wg sync.WaitGroup
-
-	for _, uf := range updateFuncs {
-		wg.Add(1)
-		go func(name string, fn func(PgCommitContext) error) {
-			defer wg.Done()
-			if err := fn(commitContext); err != nil {
-				errChan <- fmt.Errorf("%s error: %w", name, err)
-			}
-		}(uf.name, uf.fn)
+	// these read state written by updateFuncs, so they run once all of them are done
+	afterUpdateFuncs := []pgCommitFunc{
+		{"updateValidatorWithdrawn", c.updateValidatorWithdrawn},
+		{"updateValidatorStatStake", c.updateValidatorStatStake},
+		{"updateWealthDistribution", c.updateWealthDistribution},
 	}
 
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
+	// creating a partition locks the table, so it is done ahead of the
+	// transaction
+	if c.partitions != nil {
+		if err := c.partitions.Ensure(commitContext.GetTimeIndex()); err != nil {
+			return err
+		}
+	}
 
-	for err := range errChan {
+	return storedriver.CopyTransaction(context.Background(), c.db.GetDB(), func(tx *gorm.DB) error {
+		checkpoint, err := lockCheckpoint(tx, model.CheckpointChainscan)
 		if err != nil {
 			return err
 		}
+
+		committed, err := periodCommitted(checkpoint, commitContext)
+		if err != nil || committed {
+			return err
+		}
+
+		if err := c.runCommitFuncs(tx, commitContext, updateFuncs); err != nil {
+			return err
+		}
+
+		if err := c.runCommitFuncs(tx, commitContext, afterUpdateFuncs); err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "name"}},
+			UpdateAll: true,
+		}).Create(&model.Checkpoint{
+			Name:      model.CheckpointChainscan,
+			Height:    commitContext.GetHeight() - 1,
+			TimeIndex: commitContext.GetTimeIndex(),
+		}).Error
+	})
+}
+
+// runCommitFuncs runs the commit funcs one after another, the transaction is
+// bound to a single connection
+func (c *postgresStore) runCommitFuncs(tx *gorm.DB, commitContext PgCommitContext, updateFuncs []pgCommitFunc) error {
+	for _, uf := range updateFuncs {
+		if err := uf.fn(tx, commitContext); err != nil {
+			return fmt.Errorf("%s error: %w", uf.name, err)
+		}
 	}
 
 	return nil
 }
 
-func (c *postgresStore) insertBlockData(commitContext PgCommitContext) error {
-	blockData := &model.Block{Height: commitContext.GetHeight(), TimeIndex: commitContext.GetTimeIndex()}
-	if err := c.db.GetDB().Model(&model.Block{}).Create(blockData).Error; err != nil {
-		return err
+// periodCommitted tells whether the checkpoint already covers a period. A
+// period past the checkpoint must start at the block after it: committing a
+// later one would move the checkpoint over the blocks in between, and a resume
+// would never scan them again.
+func periodCommitted(checkpoint *model.Checkpoint, commitContext PgCommitContext) (bool, error) {
+	if checkpoint == nil {
+		return false, nil
 	}
-	return nil
+
+	if checkpoint.TimeIndex >= commitContext.GetTimeIndex() {
+		return true, nil
+	}
+
+	if commitContext.GetStartHeight() != checkpoint.Height+1 {
+		return false, fmt.Errorf("period of time index %d starts at height %d, the checkpoint is at height %d",
+			commitContext.GetTimeIndex(), commitContext.GetStartHeight(), checkpoint.Height)
+	}
+
+	return false, nil
+}
+
+// lockCheckpoint reads a checkpoint and locks its row until the end of the
+// transaction, it returns nil when the pipeline has not committed yet
+func lockCheckpoint(tx *gorm.DB, name string) (*model.Checkpoint, error) {
+	checkpoint := &model.Checkpoint{}
+
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", name).First(checkpoint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+func (c *postgresStore) insertBlockData(tx *gorm.DB, commitContext PgCommitContext) error {
+	blockData := &model.Block{Height: commitContext.GetHeight(), TimeIndex: commitContext.GetTimeIndex()}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "time_index"}},
+		UpdateAll: true,
+	}).Create(blockData).Error
 }
 
-func (c *postgresStore) insertGlobalState(commitContext PgCommitContext) error {
-	return c.InsertGlobalState(commitContext.GetGlobalState())
+func (c *postgresStore) insertGlobalState(tx *gorm.DB, commitContext PgCommitContext) error {
+	return upsertGlobalState(tx, commitContext.GetGlobalState())
 }
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/store/model"
+)
+
+func TestPeriodCommitted(t *testing.T) {
+	// the day of 2024-01-02 holds the blocks 8641 to 17280
+	checkpoint := &model.Checkpoint{Name: model.CheckpointChainscan, Height: 17280, TimeIndex: 1704153600}
+
+	period := func(startHeight int64, height int64, timeIndex int64) PgCommitContext {
+		return NewPgDBCommitContext(startHeight, height, 0, timeIndex, model.NewTxMerger(), model.NewGlobalState())
+	}
+
+	for _, c := range []struct {
+		name       string
+		checkpoint *model.Checkpoint
+		period     PgCommitContext
+		committed  bool
+		refused    bool
+	}{
+		{"first commit", nil, period(1, 8641, 1704067200), false, false},
+		{"next day", checkpoint, period(17281, 25921, 1704240000), false, false},
+		{"replayed day", checkpoint, period(8641, 17281, 1704153600), true, false},
+		{"earlier day", checkpoint, period(1, 8641, 1704067200), true, false},
+		{"day after a failed one", checkpoint, period(25921, 34561, 1704326400), false, true},
+		{"day started past the checkpoint", checkpoint, period(17300, 25921, 1704240000), false, true},
+	} {
+		committed, err := periodCommitted(c.checkpoint, c.period)
+
+		if committed != c.committed || (err != nil) != c.refused {
+			t.Errorf("%s: committed = %v, err = %v", c.name, committed, err)
+		}
+	}
+}
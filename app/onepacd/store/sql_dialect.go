@@ -0,0 +1,66 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// sqlDialect writes the few expressions the postgres and sqlite backends
+// spell differently, the rest of the sql of the store is common to both
+type sqlDialect interface {
+	// greatest and least of arguments that are not NULL
+	greatest(args ...string) string
+	least(args ...string) string
+	// expr in a type whose products and sums of int64 do not overflow
+	numeric(expr string) string
+	// FLOOR(LOG10(expr)) of a positive integer expr
+	exponent(expr string) string
+}
+
+func dialectOf(db *gorm.DB) sqlDialect {
+	if db.Dialector.Name() == "sqlite" {
+		return sqliteDialect{}
+	}
+	return postgresDialect{}
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) greatest(args ...string) string {
+	return fmt.Sprintf("GREATEST(%s)", strings.Join(args, ", "))
+}
+
+func (postgresDialect) least(args ...string) string {
+	return fmt.Sprintf("LEAST(%s)", strings.Join(args, ", "))
+}
+
+func (postgresDialect) numeric(expr string) string {
+	return fmt.Sprintf("%s::numeric", expr)
+}
+
+func (postgresDialect) exponent(expr string) string {
+	return fmt.Sprintf("FLOOR(LOG(%s::numeric))::int", expr)
+}
+
+// sqliteDialect relies on the scalar MAX and MIN of sqlite, and on the digit
+// count rather than on LOG, which sqlite only has when built with its math
+// functions
+type sqliteDialect struct{}
+
+func (sqliteDialect) greatest(args ...string) string {
+	return fmt.Sprintf("MAX(%s)", strings.Join(args, ", "))
+}
+
+func (sqliteDialect) least(args ...string) string {
+	return fmt.Sprintf("MIN(%s)", strings.Join(args, ", "))
+}
+
+func (sqliteDialect) numeric(expr string) string {
+	return fmt.Sprintf("CAST(%s AS REAL)", expr)
+}
+
+func (sqliteDialect) exponent(expr string) string {
+	return fmt.Sprintf("LENGTH(CAST(%s AS TEXT)) - 1", expr)
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestPostgresDialect(t *testing.T) {
+	d := postgresDialect{}
+
+	for got, want := range map[string]string{
+		d.greatest("a", "b", "0"): "GREATEST(a, b, 0)",
+		d.least("a", "b"):         "LEAST(a, b)",
+		d.numeric("rank_asc"):     "rank_asc::numeric",
+		d.exponent("balance"):     "FLOOR(LOG(balance::numeric))::int",
+	} {
+		if got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+// TestSqliteDialect evaluates the rewrites on sqlite, they must give what the
+// postgres expressions give
+func TestSqliteDialect(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := dialectOf(db)
+	if _, ok := d.(sqliteDialect); !ok {
+		t.Fatalf("dialect of sqlite is %T", d)
+	}
+
+	eval := func(expr string) float64 {
+		var value float64
+		if err := db.Raw("SELECT " + expr).Scan(&value).Error; err != nil {
+			t.Fatalf("%s: %v", expr, err)
+		}
+		return value
+	}
+
+	if got := eval(d.greatest("3", "7", "0")); got != 7 {
+		t.Errorf("greatest = %v, want 7", got)
+	}
+
+	if got := eval(d.least("3", "7", "5")); got != 3 {
+		t.Errorf("least = %v, want 3", got)
+	}
+
+	// the product overflows int64 unless it is computed in the numeric type
+	if got := eval(d.numeric("9223372036854775807") + " * 2"); got != 2*9223372036854775807.0 {
+		t.Errorf("numeric product = %v", got)
+	}
+
+	for value, want := range map[string]float64{
+		"1":                   0,
+		"9":                   0,
+		"10":                  1,
+		"999":                 2,
+		"1000":                3,
+		"1000000000000000000": 18,
+	} {
+		if got := eval(d.exponent(value)); got != want {
+			t.Errorf("exponent(%s) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -1,44 +1,138 @@
 package store
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/1pactus/1pactus-react/app/onepacd/constants"
 	"github.com/1pactus/1pactus-react/config"
+	"github.com/1pactus/1pactus-react/log"
 	"github.com/1pactus/1pactus-react/store/storedriver"
 )
 
-func Init(config *config.ConfigBase) error {
-	/*
-		if err := setupMongo(config.Mongo); err != nil {
-			return err
-		}*/
+// store backends, see config.StoreConfig
+const (
+	BackendPostgres = "postgres"
+	BackendSqlite   = "sqlite"
+	BackendMongo    = "mongo"
+)
 
-	if err := setupPostgres(config.Postgres); err != nil {
-		return err
+// NetworkConfig is what the store needs to know about a network
+type NetworkConfig struct {
+	Name           string
+	PostgresSchema string
+	// database of the mongo backend, the configured one when empty
+	MongoDatabase string
+	KafkaTopic    string
+	SupplyRules   constants.SupplyRules
+}
+
+// NetworkStore holds the stores of a single network
+type NetworkStore struct {
+	Name        string
+	SupplyRules constants.SupplyRules
+	Store       IStore
+	// nil when kafka is disabled
+	Kafka IKafka
+}
+
+var (
+	networks     = make(map[string]*NetworkStore)
+	networkNames []string
+)
+
+func InitNetwork(config *config.ConfigBase, network *NetworkConfig) (*NetworkStore, error) {
+	if _, ok := networks[network.Name]; ok {
+		return nil, fmt.Errorf("network %q is already initialized", network.Name)
+	}
+
+	ns := &NetworkStore{
+		Name:        network.Name,
+		SupplyRules: network.SupplyRules,
+	}
+
+	switch config.Store.Backend {
+	case BackendPostgres:
+		pgConf := *config.Postgres
+		pgConf.Schema = network.PostgresSchema
+
+		postgres := &postgresStore{rules: network.SupplyRules}
+		if err := setupPostgres(network.Name, &pgConf, postgres); err != nil {
+			return nil, err
+		}
+		ns.Store = postgres
+	case BackendSqlite:
+		sqliteConf := *config.Sqlite
+		sqliteConf.File = sqliteFile(sqliteConf.File, network.Name)
+
+		postgres := &postgresStore{rules: network.SupplyRules}
+		if err := setupSqlite(network.Name, &sqliteConf, postgres); err != nil {
+			return nil, err
+		}
+		ns.Store = postgres
+	case BackendMongo:
+		mongoConf := *config.Mongo
+		if network.MongoDatabase != "" {
+			mongoConf.Database = network.MongoDatabase
+		}
+
+		mongo := &mongoStore{rules: network.SupplyRules}
+		if err := setupMongo(network.Name, &mongoConf, mongo); err != nil {
+			return nil, err
+		}
+		ns.Store = mongo
+
+		log.WithKv("module", "store").WithKv("network", network.Name).Warn("the mongo store backend is legacy-only, " +
+			"the unbond, validator, payload, timing, yield, wealth, activity and audit data are not indexed and their " +
+			"queries answer unsupported, run import-mongo and switch to postgres to index them")
+	default:
+		return nil, fmt.Errorf("unknown store backend %q", config.Store.Backend)
 	}
 
 	if config.Kafka.Enable {
-		if err := setupKafka(config.Kafka); err != nil {
-			return err
+		ns.Kafka = &kafkaStore{topicBlocks: network.KafkaTopic}
+
+		if err := setupKafka(network.Name, config.Kafka, ns.Kafka); err != nil {
+			return nil, err
 		}
 	}
 
-	return nil
+	networks[network.Name] = ns
+	networkNames = append(networkNames, network.Name)
+
+	return ns, nil
+}
+
+// GetNetwork returns the stores of a network, or of the first initialized
+// network when name is empty
+func GetNetwork(name string) *NetworkStore {
+	if name == "" {
+		if len(networkNames) == 0 {
+			return nil
+		}
+		name = networkNames[0]
+	}
+
+	return networks[name]
 }
 
 func Close() {
 }
 
-/*
-func setupMongo(conf *config.MongoConfig) (err error) {
-	err = storedriver.MongoStart("base", conf, []storedriver.IMongoStore{
-		Mongo,
-	})
+func setupMongo(name string, conf *config.MongoConfig, mongo IMongo) error {
+	if err := storedriver.MongoStart(name, conf, []storedriver.IMongoStore{
+		mongo,
+	}); err != nil {
+		return err
+	}
 
-	return
-}*/
+	return nil
+}
 
-func setupPostgres(conf *config.PostgresConfig) error {
-	if err := storedriver.PostgresGormStart("base", conf, []storedriver.IPostgresGormStore{
-		Postgres,
+func setupPostgres(name string, conf *config.PostgresConfig, postgres IPostgres) error {
+	if err := storedriver.PostgresGormStart(name, conf, []storedriver.IPostgresGormStore{
+		postgres,
 	}); err != nil {
 		return err
 	}
@@ -46,9 +140,85 @@ func setupPostgres(conf *config.PostgresConfig) error {
 	return nil
 }
 
-func setupKafka(conf *config.KafkaConfig) error {
-	if err := storedriver.KafkaStart("base", conf, []storedriver.IKafkaStore{
-		Kafka,
+// sqliteFile names the database file of a network after the network, the
+// configured file getting the network name before its extension so that no
+// two networks share a file
+func sqliteFile(file string, network string) string {
+	if file == "" {
+		return network + ".db"
+	}
+
+	ext := filepath.Ext(file)
+	return strings.TrimSuffix(file, ext) + "_" + network + ext
+}
+
+func setupSqlite(name string, conf *config.SqliteConfig, postgres IPostgres) error {
+	if err := storedriver.SqliteGormStart(name, conf, []storedriver.IPostgresGormStore{
+		postgres,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// requirePostgres refuses the maintenance of the postgres schema when the
+// store runs on another backend
+func requirePostgres(config *config.ConfigBase) error {
+	if config.Store.Backend != BackendPostgres {
+		return fmt.Errorf("the %s store backend has no versioned migrations or partitions, its schema is created at startup", config.Store.Backend)
+	}
+
+	return nil
+}
+
+// Migrate opens the postgres schema of a network and runs fn with a migrator
+// over the embedded migrations
+func Migrate(config *config.ConfigBase, network *NetworkConfig, fn func(migrator *storedriver.PostgresMigrator) error) error {
+	if err := requirePostgres(config); err != nil {
+		return err
+	}
+
+	pgConf := *config.Postgres
+	pgConf.Schema = network.PostgresSchema
+
+	conn, err := storedriver.PostgresGormConnect(network.Name, &pgConf)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	migrations, err := storedriver.LoadMigrations((&postgresStore{}).Migrations())
+	if err != nil {
+		return err
+	}
+
+	return fn(storedriver.NewPostgresMigrator(conn.GetDB(), migrations, log.WithKv("module", "migrate").WithKv("network", network.Name)))
+}
+
+// Partitions opens the postgres schema of a network and runs fn with a
+// partitioner over its monthly partitioned tables
+func Partitions(config *config.ConfigBase, network *NetworkConfig, fn func(partitioner *storedriver.PostgresPartitioner) error) error {
+	if err := requirePostgres(config); err != nil {
+		return err
+	}
+
+	pgConf := *config.Postgres
+	pgConf.Schema = network.PostgresSchema
+
+	conn, err := storedriver.PostgresGormConnect(network.Name, &pgConf)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return fn(storedriver.NewPostgresPartitioner(conn.GetDB(), (&postgresStore{}).Tables(),
+		log.WithKv("module", "partition").WithKv("network", network.Name)))
+}
+
+func setupKafka(name string, conf *config.KafkaConfig, kafka IKafka) error {
+	if err := storedriver.KafkaStart(name, conf, []storedriver.IKafkaStore{
+		kafka,
 	}); err != nil {
 		return err
 	}
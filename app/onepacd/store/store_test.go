@@ -0,0 +1,16 @@
+package store
+
+import "testing"
+
+func TestSqliteFile(t *testing.T) {
+	for _, c := range []struct{ file, network, want string }{
+		{"", "mainnet", "mainnet.db"},
+		{"onepacd.db", "mainnet", "onepacd_mainnet.db"},
+		{"onepacd.db", "testnet", "onepacd_testnet.db"},
+		{"onepacd", "testnet", "onepacd_testnet"},
+	} {
+		if got := sqliteFile(c.file, c.network); got != c.want {
+			t.Errorf("sqliteFile(%q, %q) = %q, want %q", c.file, c.network, got, c.want)
+		}
+	}
+}
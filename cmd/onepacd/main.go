@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/1pactus/1pactus-react/app/onepacd"
+	"github.com/1pactus/1pactus-react/app/onepacd/store"
 	"github.com/urfave/cli/v2"
 )
 
@@ -28,15 +29,166 @@ func main() {
 					Usage:    "configuration cli overrides",
 					Required: false,
 				},
+				&cli.BoolFlag{
+					Name:  "migrate",
+					Usage: "apply pending migrations before starting",
+				},
 			},
 			Action: func(c *cli.Context) error {
 				if err := onepacd.LoadConfig(onepacd.App, c.StringSlice("config"), c.StringSlice("param")); err != nil {
 					return err
 				}
+				if c.Bool("migrate") {
+					if err := onepacd.Migrate(onepacd.MigrateUp, "", 0); err != nil {
+						return err
+					}
+				}
 				onepacd.Run()
 				return nil
 			},
 		},
+		{
+			Name: "migrate", Usage: "Manage the postgres schema version",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "config",
+					Aliases:  []string{"c"},
+					Usage:    "Load configuration from `FILE`",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:     "param",
+					Aliases:  []string{"p"},
+					Usage:    "configuration cli overrides",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:  "network",
+					Usage: "only migrate the schema of `NAME`, all networks by default",
+				},
+			},
+			Before: func(c *cli.Context) error {
+				return onepacd.LoadConfig(onepacd.App, c.StringSlice("config"), c.StringSlice("param"))
+			},
+			Subcommands: []*cli.Command{
+				{
+					Name: onepacd.MigrateUp, Usage: "Apply all pending migrations",
+					Action: func(c *cli.Context) error {
+						return onepacd.Migrate(onepacd.MigrateUp, c.String("network"), 0)
+					},
+				},
+				{
+					Name: onepacd.MigrateDown, Usage: "Revert the latest migrations",
+					Flags: []cli.Flag{
+						&cli.IntFlag{
+							Name:  "steps",
+							Usage: "number of migrations to revert",
+							Value: 1,
+						},
+					},
+					Action: func(c *cli.Context) error {
+						return onepacd.Migrate(onepacd.MigrateDown, c.String("network"), c.Int("steps"))
+					},
+				},
+				{
+					Name: onepacd.MigrateStatus, Usage: "Show applied and pending migrations",
+					Action: func(c *cli.Context) error {
+						return onepacd.Migrate(onepacd.MigrateStatus, c.String("network"), 0)
+					},
+				},
+			},
+		},
+		{
+			Name: "import-mongo", Usage: "Copy the mongo collections of a network into its postgres schema",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "config",
+					Aliases:  []string{"c"},
+					Usage:    "Load configuration from `FILE`",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:     "param",
+					Aliases:  []string{"p"},
+					Usage:    "configuration cli overrides",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:  "network",
+					Usage: "only import `NAME`, all networks by default",
+				},
+				&cli.IntFlag{
+					Name:  "batch-size",
+					Usage: "rows written per transaction",
+					Value: store.MONGO_IMPORT_BATCH_SIZE,
+				},
+				&cli.BoolFlag{
+					Name:  "validate-only",
+					Usage: "only compare the row counts and totals of both stores",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if err := onepacd.LoadConfig(onepacd.App, c.StringSlice("config"), c.StringSlice("param")); err != nil {
+					return err
+				}
+				return onepacd.ImportMongo(c.String("network"), c.Int("batch-size"), c.Bool("validate-only"))
+			},
+		},
+		{
+			Name: "partition", Usage: "Manage the monthly partitions of the address history",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "config",
+					Aliases:  []string{"c"},
+					Usage:    "Load configuration from `FILE`",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:     "param",
+					Aliases:  []string{"p"},
+					Usage:    "configuration cli overrides",
+					Required: false,
+				},
+				&cli.StringFlag{
+					Name:  "network",
+					Usage: "only manage the partitions of `NAME`, all networks by default",
+				},
+			},
+			Before: func(c *cli.Context) error {
+				return onepacd.LoadConfig(onepacd.App, c.StringSlice("config"), c.StringSlice("param"))
+			},
+			Subcommands: []*cli.Command{
+				{
+					Name: onepacd.PartitionList, Usage: "List the partitions and their row estimates",
+					Action: func(c *cli.Context) error {
+						return onepacd.Partitions(onepacd.PartitionList, c.String("network"), "", "")
+					},
+				},
+				{
+					Name: onepacd.PartitionEnsure, Usage: "Create the partitions of the current and next months",
+					Action: func(c *cli.Context) error {
+						return onepacd.Partitions(onepacd.PartitionEnsure, c.String("network"), "", "")
+					},
+				},
+				{
+					Name: onepacd.PartitionDetach, Usage: "Detach the partitions ending before a month",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:     "before",
+							Usage:    "detach the partitions ending at or before `YYYY-MM`",
+							Required: true,
+						},
+						&cli.StringFlag{
+							Name:  "archive-schema",
+							Usage: "move the detached partitions to `SCHEMA`",
+						},
+					},
+					Action: func(c *cli.Context) error {
+						return onepacd.Partitions(onepacd.PartitionDetach, c.String("network"), c.String("before"), c.String("archive-schema"))
+					},
+				},
+			},
+		},
 	}
 	err := cmd.Run(os.Args)
 	if err != nil {
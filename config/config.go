@@ -26,9 +26,11 @@ func NewDefaultAppConfig() *AppConfig {
 type ConfigBase struct {
 	App      *AppConfig      `mapstructure:"app"`
 	Log      *log.Options    `mapstructure:"log"`
+	Store    *StoreConfig    `mapstructure:"store"`
 	Mongo    *MongoConfig    `mapstructure:"mongo"`
 	Redis    *RedisConfig    `mapstructure:"redis"`
 	Postgres *PostgresConfig `mapstructure:"postgres"`
+	Sqlite   *SqliteConfig   `mapstructure:"sqlite"`
 	Kafka    *KafkaConfig    `mapstructure:"kafka"`
 }
 
@@ -36,9 +38,11 @@ func NewDefaultConfigBaseConfig() *ConfigBase {
 	return &ConfigBase{
 		App:      NewDefaultAppConfig(),
 		Log:      log.NewDefaultOptions(),
+		Store:    NewDefaultStoreConfig(),
 		Mongo:    NewDefaultMongoConfig(),
 		Redis:    NewDefaultRedisConfig(),
 		Postgres: NewDefaultPostgresConfig(),
+		Sqlite:   NewDefaultSqliteConfig(),
 		Kafka:    NewDefaultKafkaConfig(),
 	}
 }
@@ -1,5 +1,17 @@
 package config
 
+// StoreConfig selects the database the indexed data is kept in
+type StoreConfig struct {
+	// postgres, sqlite or mongo, which is legacy-only
+	Backend string `mapstructure:"backend"`
+}
+
+func NewDefaultStoreConfig() *StoreConfig {
+	return &StoreConfig{
+		Backend: "postgres",
+	}
+}
+
 type MongoConfig struct {
 	Uri         string `mapstructure:"uri"`
 	Database    string `mapstructure:"database"`
@@ -40,10 +52,23 @@ type PostgresConfig struct {
 	Port        int    `mapstructure:"port"`
 	Database    string `mapstructure:"database"`
 	Healthcheck int    `mapstructure:"healthcheck"`
+	// schema the tables live in, the default search_path when empty
+	Schema string `mapstructure:"schema"`
 
 	MaxOpenConns    int `mapstructure:"max_open_conns"`
 	MaxIdleConns    int `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime int `mapstructure:"conn_max_lifetime"`
+
+	// read-only replicas serving the read queries, with the credentials,
+	// database and pool settings of the primary
+	Replicas []PostgresReplicaConfig `mapstructure:"replicas"`
+	// seconds of replay lag past which a replica stops serving reads
+	MaxReplicaLag int `mapstructure:"max_replica_lag"`
+}
+
+type PostgresReplicaConfig struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
 }
 
 func NewDefaultPostgresConfig() *PostgresConfig {
@@ -57,6 +82,25 @@ func NewDefaultPostgresConfig() *PostgresConfig {
 		MaxOpenConns:    32,
 		MaxIdleConns:    16,
 		ConnMaxLifetime: 300,
+		MaxReplicaLag:   30,
+	}
+}
+
+// SqliteConfig is the embedded database of the sqlite backend, each network
+// is kept in its own file
+type SqliteConfig struct {
+	Dir string `mapstructure:"dir"`
+	// database file in Dir, the network name is appended to it, e.g.
+	// onepacd_mainnet.db for onepacd.db. Named after the network when empty.
+	File string `mapstructure:"file"`
+	// milliseconds a statement waits for the lock held by another connection
+	BusyTimeout int `mapstructure:"busy_timeout"`
+}
+
+func NewDefaultSqliteConfig() *SqliteConfig {
+	return &SqliteConfig{
+		Dir:         "./data",
+		BusyTimeout: 5000,
 	}
 }
 
@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/account.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccountActivityData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Active        int64                  `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	New           int64                  `protobuf:"varint,3,opt,name=new,proto3" json:"new,omitempty"`
+	Returning     int64                  `protobuf:"varint,4,opt,name=returning,proto3" json:"returning,omitempty"`
+	Reactivated   int64                  `protobuf:"varint,5,opt,name=reactivated,proto3" json:"reactivated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountActivityData) Reset() {
+	*x = AccountActivityData{}
+	mi := &file_api_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountActivityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountActivityData) ProtoMessage() {}
+
+func (x *AccountActivityData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountActivityData.ProtoReflect.Descriptor instead.
+func (*AccountActivityData) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountActivityData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *AccountActivityData) GetActive() int64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *AccountActivityData) GetNew() int64 {
+	if x != nil {
+		return x.New
+	}
+	return 0
+}
+
+func (x *AccountActivityData) GetReturning() int64 {
+	if x != nil {
+		return x.Returning
+	}
+	return 0
+}
+
+func (x *AccountActivityData) GetReactivated() int64 {
+	if x != nil {
+		return x.Reactivated
+	}
+	return 0
+}
+
+type AccountCohortData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Week          uint32                 `protobuf:"varint,1,opt,name=week,proto3" json:"week,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Retained      []int64                `protobuf:"varint,3,rep,packed,name=retained,proto3" json:"retained,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountCohortData) Reset() {
+	*x = AccountCohortData{}
+	mi := &file_api_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountCohortData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountCohortData) ProtoMessage() {}
+
+func (x *AccountCohortData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountCohortData.ProtoReflect.Descriptor instead.
+func (*AccountCohortData) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountCohortData) GetWeek() uint32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *AccountCohortData) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AccountCohortData) GetRetained() []int64 {
+	if x != nil {
+		return x.Retained
+	}
+	return nil
+}
+
+type GetAccountActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountActivityRequest) Reset() {
+	*x = GetAccountActivityRequest{}
+	mi := &file_api_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountActivityRequest) ProtoMessage() {}
+
+func (x *GetAccountActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountActivityRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountActivityRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetAccountActivityRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetAccountActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*AccountActivityData `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountActivityResponse) Reset() {
+	*x = GetAccountActivityResponse{}
+	mi := &file_api_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountActivityResponse) ProtoMessage() {}
+
+func (x *GetAccountActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountActivityResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAccountActivityResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAccountActivityResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAccountActivityResponse) GetLines() []*AccountActivityData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetAccountRetentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weeks         int32                  `protobuf:"varint,1,opt,name=weeks,proto3" json:"weeks,omitempty" form:"weeks"`         // @gotags: form:"weeks"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRetentionRequest) Reset() {
+	*x = GetAccountRetentionRequest{}
+	mi := &file_api_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRetentionRequest) ProtoMessage() {}
+
+func (x *GetAccountRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRetentionRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccountRetentionRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *GetAccountRetentionRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetAccountRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Cohorts       []*AccountCohortData   `protobuf:"bytes,3,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRetentionResponse) Reset() {
+	*x = GetAccountRetentionResponse{}
+	mi := &file_api_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRetentionResponse) ProtoMessage() {}
+
+func (x *GetAccountRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRetentionResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAccountRetentionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAccountRetentionResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAccountRetentionResponse) GetCohorts() []*AccountCohortData {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+var File_api_account_proto protoreflect.FileDescriptor
+
+const file_api_account_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/account.proto\x12\x03api\"\x9e\x01\n" +
+	"\x13AccountActivityData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x10\n" +
+	"\x03new\x18\x03 \x01(\x03R\x03new\x12\x1c\n" +
+	"\treturning\x18\x04 \x01(\x03R\treturning\x12 \n" +
+	"\vreactivated\x18\x05 \x01(\x03R\vreactivated\"W\n" +
+	"\x11AccountCohortData\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\rR\x04week\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bretained\x18\x03 \x03(\x03R\bretained\"K\n" +
+	"\x19GetAccountActivityRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"r\n" +
+	"\x1aGetAccountActivityResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12.\n" +
+	"\x05lines\x18\x03 \x03(\v2\x18.api.AccountActivityDataR\x05lines\"N\n" +
+	"\x1aGetAccountRetentionRequest\x12\x14\n" +
+	"\x05weeks\x18\x01 \x01(\x05R\x05weeks\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"u\n" +
+	"\x1bGetAccountRetentionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x120\n" +
+	"\acohorts\x18\x03 \x03(\v2\x16.api.AccountCohortDataR\acohortsB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_account_proto_rawDescOnce sync.Once
+	file_api_account_proto_rawDescData []byte
+)
+
+func file_api_account_proto_rawDescGZIP() []byte {
+	file_api_account_proto_rawDescOnce.Do(func() {
+		file_api_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_account_proto_rawDesc), len(file_api_account_proto_rawDesc)))
+	})
+	return file_api_account_proto_rawDescData
+}
+
+var file_api_account_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_account_proto_goTypes = []any{
+	(*AccountActivityData)(nil),         // 0: api.AccountActivityData
+	(*AccountCohortData)(nil),           // 1: api.AccountCohortData
+	(*GetAccountActivityRequest)(nil),   // 2: api.GetAccountActivityRequest
+	(*GetAccountActivityResponse)(nil),  // 3: api.GetAccountActivityResponse
+	(*GetAccountRetentionRequest)(nil),  // 4: api.GetAccountRetentionRequest
+	(*GetAccountRetentionResponse)(nil), // 5: api.GetAccountRetentionResponse
+}
+var file_api_account_proto_depIdxs = []int32{
+	0, // 0: api.GetAccountActivityResponse.lines:type_name -> api.AccountActivityData
+	1, // 1: api.GetAccountRetentionResponse.cohorts:type_name -> api.AccountCohortData
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_account_proto_init() }
+func file_api_account_proto_init() {
+	if File_api_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_account_proto_rawDesc), len(file_api_account_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_account_proto_goTypes,
+		DependencyIndexes: file_api_account_proto_depIdxs,
+		MessageInfos:      file_api_account_proto_msgTypes,
+	}.Build()
+	File_api_account_proto = out.File
+	file_api_account_proto_goTypes = nil
+	file_api_account_proto_depIdxs = nil
+}
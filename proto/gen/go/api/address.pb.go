@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/address.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddressFlowData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Inflow        int64                  `protobuf:"varint,2,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Outflow       int64                  `protobuf:"varint,3,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	TransferIn    int64                  `protobuf:"varint,4,opt,name=transfer_in,json=transferIn,proto3" json:"transfer_in,omitempty"`
+	TransferOut   int64                  `protobuf:"varint,5,opt,name=transfer_out,json=transferOut,proto3" json:"transfer_out,omitempty"`
+	Reward        int64                  `protobuf:"varint,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	BondIn        int64                  `protobuf:"varint,7,opt,name=bond_in,json=bondIn,proto3" json:"bond_in,omitempty"`
+	BondOut       int64                  `protobuf:"varint,8,opt,name=bond_out,json=bondOut,proto3" json:"bond_out,omitempty"`
+	WithdrawIn    int64                  `protobuf:"varint,9,opt,name=withdraw_in,json=withdrawIn,proto3" json:"withdraw_in,omitempty"`
+	WithdrawOut   int64                  `protobuf:"varint,10,opt,name=withdraw_out,json=withdrawOut,proto3" json:"withdraw_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressFlowData) Reset() {
+	*x = AddressFlowData{}
+	mi := &file_api_address_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressFlowData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressFlowData) ProtoMessage() {}
+
+func (x *AddressFlowData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressFlowData.ProtoReflect.Descriptor instead.
+func (*AddressFlowData) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddressFlowData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetInflow() int64 {
+	if x != nil {
+		return x.Inflow
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetOutflow() int64 {
+	if x != nil {
+		return x.Outflow
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetTransferIn() int64 {
+	if x != nil {
+		return x.TransferIn
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetTransferOut() int64 {
+	if x != nil {
+		return x.TransferOut
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetBondIn() int64 {
+	if x != nil {
+		return x.BondIn
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetBondOut() int64 {
+	if x != nil {
+		return x.BondOut
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetWithdrawIn() int64 {
+	if x != nil {
+		return x.WithdrawIn
+	}
+	return 0
+}
+
+func (x *AddressFlowData) GetWithdrawOut() int64 {
+	if x != nil {
+		return x.WithdrawOut
+	}
+	return 0
+}
+
+type GetAddressFlowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressFlowsRequest) Reset() {
+	*x = GetAddressFlowsRequest{}
+	mi := &file_api_address_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressFlowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressFlowsRequest) ProtoMessage() {}
+
+func (x *GetAddressFlowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressFlowsRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressFlowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAddressFlowsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetAddressFlowsRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetAddressFlowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*AddressFlowData     `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressFlowsResponse) Reset() {
+	*x = GetAddressFlowsResponse{}
+	mi := &file_api_address_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressFlowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressFlowsResponse) ProtoMessage() {}
+
+func (x *GetAddressFlowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressFlowsResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressFlowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAddressFlowsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAddressFlowsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAddressFlowsResponse) GetLines() []*AddressFlowData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type AddressCounterpartyData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Sent          int64                  `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	Received      int64                  `protobuf:"varint,3,opt,name=received,proto3" json:"received,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressCounterpartyData) Reset() {
+	*x = AddressCounterpartyData{}
+	mi := &file_api_address_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressCounterpartyData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressCounterpartyData) ProtoMessage() {}
+
+func (x *AddressCounterpartyData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressCounterpartyData.ProtoReflect.Descriptor instead.
+func (*AddressCounterpartyData) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddressCounterpartyData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressCounterpartyData) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *AddressCounterpartyData) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+type GetAddressCounterpartiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`         // @gotags: form:"limit"
+	Datatype      string                 `protobuf:"bytes,3,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressCounterpartiesRequest) Reset() {
+	*x = GetAddressCounterpartiesRequest{}
+	mi := &file_api_address_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressCounterpartiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressCounterpartiesRequest) ProtoMessage() {}
+
+func (x *GetAddressCounterpartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressCounterpartiesRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressCounterpartiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAddressCounterpartiesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetAddressCounterpartiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAddressCounterpartiesRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetAddressCounterpartiesResponse struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	Code           int32                      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg            string                     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Counterparties []*AddressCounterpartyData `protobuf:"bytes,3,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAddressCounterpartiesResponse) Reset() {
+	*x = GetAddressCounterpartiesResponse{}
+	mi := &file_api_address_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressCounterpartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressCounterpartiesResponse) ProtoMessage() {}
+
+func (x *GetAddressCounterpartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_address_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressCounterpartiesResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressCounterpartiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_address_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAddressCounterpartiesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAddressCounterpartiesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAddressCounterpartiesResponse) GetCounterparties() []*AddressCounterpartyData {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+var File_api_address_proto protoreflect.FileDescriptor
+
+const file_api_address_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/address.proto\x12\x03api\"\xb6\x02\n" +
+	"\x0fAddressFlowData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x16\n" +
+	"\x06inflow\x18\x02 \x01(\x03R\x06inflow\x12\x18\n" +
+	"\aoutflow\x18\x03 \x01(\x03R\aoutflow\x12\x1f\n" +
+	"\vtransfer_in\x18\x04 \x01(\x03R\n" +
+	"transferIn\x12!\n" +
+	"\ftransfer_out\x18\x05 \x01(\x03R\vtransferOut\x12\x16\n" +
+	"\x06reward\x18\x06 \x01(\x03R\x06reward\x12\x17\n" +
+	"\abond_in\x18\a \x01(\x03R\x06bondIn\x12\x19\n" +
+	"\bbond_out\x18\b \x01(\x03R\abondOut\x12\x1f\n" +
+	"\vwithdraw_in\x18\t \x01(\x03R\n" +
+	"withdrawIn\x12!\n" +
+	"\fwithdraw_out\x18\n" +
+	" \x01(\x03R\vwithdrawOut\"H\n" +
+	"\x16GetAddressFlowsRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"k\n" +
+	"\x17GetAddressFlowsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12*\n" +
+	"\x05lines\x18\x03 \x03(\v2\x14.api.AddressFlowDataR\x05lines\"c\n" +
+	"\x17AddressCounterpartyData\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04sent\x18\x02 \x01(\x03R\x04sent\x12\x1a\n" +
+	"\breceived\x18\x03 \x01(\x03R\breceived\"g\n" +
+	"\x1fGetAddressCounterpartiesRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bdatatype\x18\x03 \x01(\tR\bdatatype\"\x8e\x01\n" +
+	" GetAddressCounterpartiesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12D\n" +
+	"\x0ecounterparties\x18\x03 \x03(\v2\x1c.api.AddressCounterpartyDataR\x0ecounterpartiesB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_address_proto_rawDescOnce sync.Once
+	file_api_address_proto_rawDescData []byte
+)
+
+func file_api_address_proto_rawDescGZIP() []byte {
+	file_api_address_proto_rawDescOnce.Do(func() {
+		file_api_address_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_address_proto_rawDesc), len(file_api_address_proto_rawDesc)))
+	})
+	return file_api_address_proto_rawDescData
+}
+
+var file_api_address_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_address_proto_goTypes = []any{
+	(*AddressFlowData)(nil),                  // 0: api.AddressFlowData
+	(*GetAddressFlowsRequest)(nil),           // 1: api.GetAddressFlowsRequest
+	(*GetAddressFlowsResponse)(nil),          // 2: api.GetAddressFlowsResponse
+	(*AddressCounterpartyData)(nil),          // 3: api.AddressCounterpartyData
+	(*GetAddressCounterpartiesRequest)(nil),  // 4: api.GetAddressCounterpartiesRequest
+	(*GetAddressCounterpartiesResponse)(nil), // 5: api.GetAddressCounterpartiesResponse
+}
+var file_api_address_proto_depIdxs = []int32{
+	0, // 0: api.GetAddressFlowsResponse.lines:type_name -> api.AddressFlowData
+	3, // 1: api.GetAddressCounterpartiesResponse.counterparties:type_name -> api.AddressCounterpartyData
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_address_proto_init() }
+func file_api_address_proto_init() {
+	if File_api_address_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_address_proto_rawDesc), len(file_api_address_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_address_proto_goTypes,
+		DependencyIndexes: file_api_address_proto_depIdxs,
+		MessageInfos:      file_api_address_proto_msgTypes,
+	}.Build()
+	File_api_address_proto = out.File
+	file_api_address_proto_goTypes = nil
+	file_api_address_proto_depIdxs = nil
+}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/admin.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TriggerScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Datatype      string                 `protobuf:"bytes,1,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScanRequest) Reset() {
+	*x = TriggerScanRequest{}
+	mi := &file_api_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScanRequest) ProtoMessage() {}
+
+func (x *TriggerScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScanRequest.ProtoReflect.Descriptor instead.
+func (*TriggerScanRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TriggerScanRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type TriggerScanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// false when a scan was already queued
+	Queued        bool `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerScanResponse) Reset() {
+	*x = TriggerScanResponse{}
+	mi := &file_api_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerScanResponse) ProtoMessage() {}
+
+func (x *TriggerScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerScanResponse.ProtoReflect.Descriptor instead.
+func (*TriggerScanResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TriggerScanResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TriggerScanResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *TriggerScanResponse) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+var File_api_admin_proto protoreflect.FileDescriptor
+
+const file_api_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x0fapi/admin.proto\x12\x03api\"0\n" +
+	"\x12TriggerScanRequest\x12\x1a\n" +
+	"\bdatatype\x18\x01 \x01(\tR\bdatatype\"S\n" +
+	"\x13TriggerScanResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\x16\n" +
+	"\x06queued\x18\x03 \x01(\bR\x06queuedB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_admin_proto_rawDescOnce sync.Once
+	file_api_admin_proto_rawDescData []byte
+)
+
+func file_api_admin_proto_rawDescGZIP() []byte {
+	file_api_admin_proto_rawDescOnce.Do(func() {
+		file_api_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_admin_proto_rawDesc), len(file_api_admin_proto_rawDesc)))
+	})
+	return file_api_admin_proto_rawDescData
+}
+
+var file_api_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_admin_proto_goTypes = []any{
+	(*TriggerScanRequest)(nil),  // 0: api.TriggerScanRequest
+	(*TriggerScanResponse)(nil), // 1: api.TriggerScanResponse
+}
+var file_api_admin_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_proto_init() }
+func file_api_admin_proto_init() {
+	if File_api_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_admin_proto_rawDesc), len(file_api_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_admin_proto_goTypes,
+		DependencyIndexes: file_api_admin_proto_depIdxs,
+		MessageInfos:      file_api_admin_proto_msgTypes,
+	}.Build()
+	File_api_admin_proto = out.File
+	file_api_admin_proto_goTypes = nil
+	file_api_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/audit.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditRecordData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Check         string                 `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Indexed       int64                  `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	Node          int64                  `protobuf:"varint,4,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecordData) Reset() {
+	*x = AuditRecordData{}
+	mi := &file_api_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecordData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecordData) ProtoMessage() {}
+
+func (x *AuditRecordData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecordData.ProtoReflect.Descriptor instead.
+func (*AuditRecordData) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditRecordData) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *AuditRecordData) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditRecordData) GetIndexed() int64 {
+	if x != nil {
+		return x.Indexed
+	}
+	return 0
+}
+
+func (x *AuditRecordData) GetNode() int64 {
+	if x != nil {
+		return x.Node
+	}
+	return 0
+}
+
+type AuditData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	IndexedHeight int64                  `protobuf:"varint,2,opt,name=indexed_height,json=indexedHeight,proto3" json:"indexed_height,omitempty"`
+	NodeHeight    int64                  `protobuf:"varint,3,opt,name=node_height,json=nodeHeight,proto3" json:"node_height,omitempty"`
+	Checks        int64                  `protobuf:"varint,4,opt,name=checks,proto3" json:"checks,omitempty"`
+	Discrepancies int64                  `protobuf:"varint,5,opt,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Records       []*AuditRecordData     `protobuf:"bytes,6,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditData) Reset() {
+	*x = AuditData{}
+	mi := &file_api_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditData) ProtoMessage() {}
+
+func (x *AuditData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditData.ProtoReflect.Descriptor instead.
+func (*AuditData) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditData) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AuditData) GetIndexedHeight() int64 {
+	if x != nil {
+		return x.IndexedHeight
+	}
+	return 0
+}
+
+func (x *AuditData) GetNodeHeight() int64 {
+	if x != nil {
+		return x.NodeHeight
+	}
+	return 0
+}
+
+func (x *AuditData) GetChecks() int64 {
+	if x != nil {
+		return x.Checks
+	}
+	return 0
+}
+
+func (x *AuditData) GetDiscrepancies() int64 {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return 0
+}
+
+func (x *AuditData) GetRecords() []*AuditRecordData {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetLatestAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Datatype      string                 `protobuf:"bytes,1,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestAuditRequest) Reset() {
+	*x = GetLatestAuditRequest{}
+	mi := &file_api_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestAuditRequest) ProtoMessage() {}
+
+func (x *GetLatestAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestAuditRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestAuditRequest) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLatestAuditRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetLatestAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Audit         *AuditData             `protobuf:"bytes,3,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestAuditResponse) Reset() {
+	*x = GetLatestAuditResponse{}
+	mi := &file_api_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestAuditResponse) ProtoMessage() {}
+
+func (x *GetLatestAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestAuditResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestAuditResponse) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLatestAuditResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetLatestAuditResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetLatestAuditResponse) GetAudit() *AuditData {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+var File_api_audit_proto protoreflect.FileDescriptor
+
+const file_api_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x0fapi/audit.proto\x12\x03api\"o\n" +
+	"\x0fAuditRecordData\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x18\n" +
+	"\aindexed\x18\x03 \x01(\x03R\aindexed\x12\x12\n" +
+	"\x04node\x18\x04 \x01(\x03R\x04node\"\xd5\x01\n" +
+	"\tAuditData\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12%\n" +
+	"\x0eindexed_height\x18\x02 \x01(\x03R\rindexedHeight\x12\x1f\n" +
+	"\vnode_height\x18\x03 \x01(\x03R\n" +
+	"nodeHeight\x12\x16\n" +
+	"\x06checks\x18\x04 \x01(\x03R\x06checks\x12$\n" +
+	"\rdiscrepancies\x18\x05 \x01(\x03R\rdiscrepancies\x12.\n" +
+	"\arecords\x18\x06 \x03(\v2\x14.api.AuditRecordDataR\arecords\"3\n" +
+	"\x15GetLatestAuditRequest\x12\x1a\n" +
+	"\bdatatype\x18\x01 \x01(\tR\bdatatype\"d\n" +
+	"\x16GetLatestAuditResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12$\n" +
+	"\x05audit\x18\x03 \x01(\v2\x0e.api.AuditDataR\x05auditB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_audit_proto_rawDescOnce sync.Once
+	file_api_audit_proto_rawDescData []byte
+)
+
+func file_api_audit_proto_rawDescGZIP() []byte {
+	file_api_audit_proto_rawDescOnce.Do(func() {
+		file_api_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_audit_proto_rawDesc), len(file_api_audit_proto_rawDesc)))
+	})
+	return file_api_audit_proto_rawDescData
+}
+
+var file_api_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_audit_proto_goTypes = []any{
+	(*AuditRecordData)(nil),        // 0: api.AuditRecordData
+	(*AuditData)(nil),              // 1: api.AuditData
+	(*GetLatestAuditRequest)(nil),  // 2: api.GetLatestAuditRequest
+	(*GetLatestAuditResponse)(nil), // 3: api.GetLatestAuditResponse
+}
+var file_api_audit_proto_depIdxs = []int32{
+	0, // 0: api.AuditData.records:type_name -> api.AuditRecordData
+	1, // 1: api.GetLatestAuditResponse.audit:type_name -> api.AuditData
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_audit_proto_init() }
+func file_api_audit_proto_init() {
+	if File_api_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_audit_proto_rawDesc), len(file_api_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_audit_proto_goTypes,
+		DependencyIndexes: file_api_audit_proto_depIdxs,
+		MessageInfos:      file_api_audit_proto_msgTypes,
+	}.Build()
+	File_api_audit_proto = out.File
+	file_api_audit_proto_goTypes = nil
+	file_api_audit_proto_depIdxs = nil
+}
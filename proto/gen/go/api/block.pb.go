@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/block.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockRoundData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Blocks        int64                  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRoundData) Reset() {
+	*x = BlockRoundData{}
+	mi := &file_api_block_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRoundData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRoundData) ProtoMessage() {}
+
+func (x *BlockRoundData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_block_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRoundData.ProtoReflect.Descriptor instead.
+func (*BlockRoundData) Descriptor() ([]byte, []int) {
+	return file_api_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockRoundData) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BlockRoundData) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+// block interval statistics of a day, in seconds
+type BlockTimingData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Intervals     int64                  `protobuf:"varint,2,opt,name=intervals,proto3" json:"intervals,omitempty"`
+	IntervalMean  float64                `protobuf:"fixed64,3,opt,name=interval_mean,json=intervalMean,proto3" json:"interval_mean,omitempty"`
+	IntervalP50   int64                  `protobuf:"varint,4,opt,name=interval_p50,json=intervalP50,proto3" json:"interval_p50,omitempty"`
+	IntervalP95   int64                  `protobuf:"varint,5,opt,name=interval_p95,json=intervalP95,proto3" json:"interval_p95,omitempty"`
+	IntervalMax   int64                  `protobuf:"varint,6,opt,name=interval_max,json=intervalMax,proto3" json:"interval_max,omitempty"`
+	SlowBlocks    int64                  `protobuf:"varint,7,opt,name=slow_blocks,json=slowBlocks,proto3" json:"slow_blocks,omitempty"`
+	SlowThreshold int64                  `protobuf:"varint,8,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"`
+	RoundChanges  int64                  `protobuf:"varint,9,opt,name=round_changes,json=roundChanges,proto3" json:"round_changes,omitempty"`
+	MaxRound      int32                  `protobuf:"varint,10,opt,name=max_round,json=maxRound,proto3" json:"max_round,omitempty"`
+	Rounds        []*BlockRoundData      `protobuf:"bytes,11,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockTimingData) Reset() {
+	*x = BlockTimingData{}
+	mi := &file_api_block_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTimingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTimingData) ProtoMessage() {}
+
+func (x *BlockTimingData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_block_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTimingData.ProtoReflect.Descriptor instead.
+func (*BlockTimingData) Descriptor() ([]byte, []int) {
+	return file_api_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockTimingData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetIntervals() int64 {
+	if x != nil {
+		return x.Intervals
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetIntervalMean() float64 {
+	if x != nil {
+		return x.IntervalMean
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetIntervalP50() int64 {
+	if x != nil {
+		return x.IntervalP50
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetIntervalP95() int64 {
+	if x != nil {
+		return x.IntervalP95
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetIntervalMax() int64 {
+	if x != nil {
+		return x.IntervalMax
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetSlowBlocks() int64 {
+	if x != nil {
+		return x.SlowBlocks
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetSlowThreshold() int64 {
+	if x != nil {
+		return x.SlowThreshold
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetRoundChanges() int64 {
+	if x != nil {
+		return x.RoundChanges
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetMaxRound() int32 {
+	if x != nil {
+		return x.MaxRound
+	}
+	return 0
+}
+
+func (x *BlockTimingData) GetRounds() []*BlockRoundData {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type GetBlockTimingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockTimingRequest) Reset() {
+	*x = GetBlockTimingRequest{}
+	mi := &file_api_block_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockTimingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockTimingRequest) ProtoMessage() {}
+
+func (x *GetBlockTimingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_block_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockTimingRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockTimingRequest) Descriptor() ([]byte, []int) {
+	return file_api_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBlockTimingRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetBlockTimingRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetBlockTimingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*BlockTimingData     `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockTimingResponse) Reset() {
+	*x = GetBlockTimingResponse{}
+	mi := &file_api_block_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockTimingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockTimingResponse) ProtoMessage() {}
+
+func (x *GetBlockTimingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_block_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockTimingResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockTimingResponse) Descriptor() ([]byte, []int) {
+	return file_api_block_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBlockTimingResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetBlockTimingResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetBlockTimingResponse) GetLines() []*BlockTimingData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_api_block_proto protoreflect.FileDescriptor
+
+const file_api_block_proto_rawDesc = "" +
+	"\n" +
+	"\x0fapi/block.proto\x12\x03api\">\n" +
+	"\x0eBlockRoundData\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x16\n" +
+	"\x06blocks\x18\x02 \x01(\x03R\x06blocks\"\x93\x03\n" +
+	"\x0fBlockTimingData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x1c\n" +
+	"\tintervals\x18\x02 \x01(\x03R\tintervals\x12#\n" +
+	"\rinterval_mean\x18\x03 \x01(\x01R\fintervalMean\x12!\n" +
+	"\finterval_p50\x18\x04 \x01(\x03R\vintervalP50\x12!\n" +
+	"\finterval_p95\x18\x05 \x01(\x03R\vintervalP95\x12!\n" +
+	"\finterval_max\x18\x06 \x01(\x03R\vintervalMax\x12\x1f\n" +
+	"\vslow_blocks\x18\a \x01(\x03R\n" +
+	"slowBlocks\x12%\n" +
+	"\x0eslow_threshold\x18\b \x01(\x03R\rslowThreshold\x12#\n" +
+	"\rround_changes\x18\t \x01(\x03R\froundChanges\x12\x1b\n" +
+	"\tmax_round\x18\n" +
+	" \x01(\x05R\bmaxRound\x12+\n" +
+	"\x06rounds\x18\v \x03(\v2\x13.api.BlockRoundDataR\x06rounds\"G\n" +
+	"\x15GetBlockTimingRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"j\n" +
+	"\x16GetBlockTimingResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12*\n" +
+	"\x05lines\x18\x03 \x03(\v2\x14.api.BlockTimingDataR\x05linesB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_block_proto_rawDescOnce sync.Once
+	file_api_block_proto_rawDescData []byte
+)
+
+func file_api_block_proto_rawDescGZIP() []byte {
+	file_api_block_proto_rawDescOnce.Do(func() {
+		file_api_block_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_block_proto_rawDesc), len(file_api_block_proto_rawDesc)))
+	})
+	return file_api_block_proto_rawDescData
+}
+
+var file_api_block_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_block_proto_goTypes = []any{
+	(*BlockRoundData)(nil),         // 0: api.BlockRoundData
+	(*BlockTimingData)(nil),        // 1: api.BlockTimingData
+	(*GetBlockTimingRequest)(nil),  // 2: api.GetBlockTimingRequest
+	(*GetBlockTimingResponse)(nil), // 3: api.GetBlockTimingResponse
+}
+var file_api_block_proto_depIdxs = []int32{
+	0, // 0: api.BlockTimingData.rounds:type_name -> api.BlockRoundData
+	1, // 1: api.GetBlockTimingResponse.lines:type_name -> api.BlockTimingData
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_block_proto_init() }
+func file_api_block_proto_init() {
+	if File_api_block_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_block_proto_rawDesc), len(file_api_block_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_block_proto_goTypes,
+		DependencyIndexes: file_api_block_proto_depIdxs,
+		MessageInfos:      file_api_block_proto_msgTypes,
+	}.Build()
+	File_api_block_proto = out.File
+	file_api_block_proto_goTypes = nil
+	file_api_block_proto_depIdxs = nil
+}
@@ -33,6 +33,15 @@ type NetworkStatusData struct {
 	Fee               int64                  `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
 	ActiveValidator   int64                  `protobuf:"varint,8,opt,name=active_validator,json=activeValidator,proto3" json:"active_validator,omitempty"`
 	ActiveAccount     int64                  `protobuf:"varint,9,opt,name=active_account,json=activeAccount,proto3" json:"active_account,omitempty"`
+	Payloads          []*PayloadStatData     `protobuf:"bytes,10,rep,name=payloads,proto3" json:"payloads,omitempty"`
+	Sortitions        int64                  `protobuf:"varint,11,opt,name=sortitions,proto3" json:"sortitions,omitempty"`
+	CommitteeSize     int64                  `protobuf:"varint,12,opt,name=committee_size,json=committeeSize,proto3" json:"committee_size,omitempty"`
+	CommitteeTurnover int64                  `protobuf:"varint,13,opt,name=committee_turnover,json=committeeTurnover,proto3" json:"committee_turnover,omitempty"`
+	CertSigned        int64                  `protobuf:"varint,14,opt,name=cert_signed,json=certSigned,proto3" json:"cert_signed,omitempty"`
+	CertMissed        int64                  `protobuf:"varint,15,opt,name=cert_missed,json=certMissed,proto3" json:"cert_missed,omitempty"`
+	Participation     float64                `protobuf:"fixed64,16,opt,name=participation,proto3" json:"participation,omitempty"`
+	Reward            int64                  `protobuf:"varint,17,opt,name=reward,proto3" json:"reward,omitempty"`
+	Yield             float64                `protobuf:"fixed64,18,opt,name=yield,proto3" json:"yield,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -130,6 +139,170 @@ func (x *NetworkStatusData) GetActiveAccount() int64 {
 	return 0
 }
 
+func (x *NetworkStatusData) GetPayloads() []*PayloadStatData {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+func (x *NetworkStatusData) GetSortitions() int64 {
+	if x != nil {
+		return x.Sortitions
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetCommitteeSize() int64 {
+	if x != nil {
+		return x.CommitteeSize
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetCommitteeTurnover() int64 {
+	if x != nil {
+		return x.CommitteeTurnover
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetCertSigned() int64 {
+	if x != nil {
+		return x.CertSigned
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetCertMissed() int64 {
+	if x != nil {
+		return x.CertMissed
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetParticipation() float64 {
+	if x != nil {
+		return x.Participation
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *NetworkStatusData) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+// transaction statistics of a pactus.PayloadType within a day
+type PayloadStatData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayloadType   int32                  `protobuf:"varint,1,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Txs           int64                  `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	Volume        int64                  `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Fee           int64                  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeMin        int64                  `protobuf:"varint,5,opt,name=fee_min,json=feeMin,proto3" json:"fee_min,omitempty"`
+	FeeMedian     int64                  `protobuf:"varint,6,opt,name=fee_median,json=feeMedian,proto3" json:"fee_median,omitempty"`
+	FeeP90        int64                  `protobuf:"varint,7,opt,name=fee_p90,json=feeP90,proto3" json:"fee_p90,omitempty"`
+	FeeMax        int64                  `protobuf:"varint,8,opt,name=fee_max,json=feeMax,proto3" json:"fee_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayloadStatData) Reset() {
+	*x = PayloadStatData{}
+	mi := &file_api_blockchain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayloadStatData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadStatData) ProtoMessage() {}
+
+func (x *PayloadStatData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blockchain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadStatData.ProtoReflect.Descriptor instead.
+func (*PayloadStatData) Descriptor() ([]byte, []int) {
+	return file_api_blockchain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayloadStatData) GetPayloadType() int32 {
+	if x != nil {
+		return x.PayloadType
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetTxs() int64 {
+	if x != nil {
+		return x.Txs
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetFeeMin() int64 {
+	if x != nil {
+		return x.FeeMin
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetFeeMedian() int64 {
+	if x != nil {
+		return x.FeeMedian
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetFeeP90() int64 {
+	if x != nil {
+		return x.FeeP90
+	}
+	return 0
+}
+
+func (x *PayloadStatData) GetFeeMax() int64 {
+	if x != nil {
+		return x.FeeMax
+	}
+	return 0
+}
+
 type GetNetworkHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
@@ -140,7 +313,7 @@ type GetNetworkHealthRequest struct {
 
 func (x *GetNetworkHealthRequest) Reset() {
 	*x = GetNetworkHealthRequest{}
-	mi := &file_api_blockchain_proto_msgTypes[1]
+	mi := &file_api_blockchain_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -152,7 +325,7 @@ func (x *GetNetworkHealthRequest) String() string {
 func (*GetNetworkHealthRequest) ProtoMessage() {}
 
 func (x *GetNetworkHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_blockchain_proto_msgTypes[1]
+	mi := &file_api_blockchain_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +338,7 @@ func (x *GetNetworkHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkHealthRequest.ProtoReflect.Descriptor instead.
 func (*GetNetworkHealthRequest) Descriptor() ([]byte, []int) {
-	return file_api_blockchain_proto_rawDescGZIP(), []int{1}
+	return file_api_blockchain_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetNetworkHealthRequest) GetDays() int32 {
@@ -193,7 +366,7 @@ type GetNetworkHealthResponse struct {
 
 func (x *GetNetworkHealthResponse) Reset() {
 	*x = GetNetworkHealthResponse{}
-	mi := &file_api_blockchain_proto_msgTypes[2]
+	mi := &file_api_blockchain_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +378,7 @@ func (x *GetNetworkHealthResponse) String() string {
 func (*GetNetworkHealthResponse) ProtoMessage() {}
 
 func (x *GetNetworkHealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_blockchain_proto_msgTypes[2]
+	mi := &file_api_blockchain_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +391,7 @@ func (x *GetNetworkHealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkHealthResponse.ProtoReflect.Descriptor instead.
 func (*GetNetworkHealthResponse) Descriptor() ([]byte, []int) {
-	return file_api_blockchain_proto_rawDescGZIP(), []int{2}
+	return file_api_blockchain_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetNetworkHealthResponse) GetCode() int32 {
@@ -242,11 +415,216 @@ func (x *GetNetworkHealthResponse) GetLines() []*NetworkStatusData {
 	return nil
 }
 
+// estimated distinct active addresses between two time indexes, both included
+type DistinctActiveData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          uint32                 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            uint32                 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Days          int64                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+	Accounts      int64                  `protobuf:"varint,4,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Validators    int64                  `protobuf:"varint,5,opt,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistinctActiveData) Reset() {
+	*x = DistinctActiveData{}
+	mi := &file_api_blockchain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistinctActiveData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistinctActiveData) ProtoMessage() {}
+
+func (x *DistinctActiveData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blockchain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistinctActiveData.ProtoReflect.Descriptor instead.
+func (*DistinctActiveData) Descriptor() ([]byte, []int) {
+	return file_api_blockchain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DistinctActiveData) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DistinctActiveData) GetTo() uint32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *DistinctActiveData) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *DistinctActiveData) GetAccounts() int64 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *DistinctActiveData) GetValidators() int64 {
+	if x != nil {
+		return x.Validators
+	}
+	return 0
+}
+
+type GetDistinctActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	From          uint32                 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty" form:"from"`            // @gotags: form:"from"
+	To            uint32                 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty" form:"to"`                  // @gotags: form:"to"
+	Datatype      string                 `protobuf:"bytes,4,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDistinctActiveRequest) Reset() {
+	*x = GetDistinctActiveRequest{}
+	mi := &file_api_blockchain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistinctActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistinctActiveRequest) ProtoMessage() {}
+
+func (x *GetDistinctActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blockchain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistinctActiveRequest.ProtoReflect.Descriptor instead.
+func (*GetDistinctActiveRequest) Descriptor() ([]byte, []int) {
+	return file_api_blockchain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDistinctActiveRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetDistinctActiveRequest) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetDistinctActiveRequest) GetTo() uint32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetDistinctActiveRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetDistinctActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Data          *DistinctActiveData    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDistinctActiveResponse) Reset() {
+	*x = GetDistinctActiveResponse{}
+	mi := &file_api_blockchain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDistinctActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDistinctActiveResponse) ProtoMessage() {}
+
+func (x *GetDistinctActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blockchain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDistinctActiveResponse.ProtoReflect.Descriptor instead.
+func (*GetDistinctActiveResponse) Descriptor() ([]byte, []int) {
+	return file_api_blockchain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDistinctActiveResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDistinctActiveResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetDistinctActiveResponse) GetData() *DistinctActiveData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_api_blockchain_proto protoreflect.FileDescriptor
 
 const file_api_blockchain_proto_rawDesc = "" +
 	"\n" +
-	"\x14api/blockchain.proto\x12\x03api\"\x9d\x02\n" +
+	"\x14api/blockchain.proto\x12\x03api\"\xdb\x04\n" +
 	"\x11NetworkStatusData\x12\x1d\n" +
 	"\n" +
 	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x14\n" +
@@ -257,14 +635,55 @@ const file_api_blockchain_proto_rawDesc = "" +
 	"\x06blocks\x18\x06 \x01(\x03R\x06blocks\x12\x10\n" +
 	"\x03fee\x18\a \x01(\x03R\x03fee\x12)\n" +
 	"\x10active_validator\x18\b \x01(\x03R\x0factiveValidator\x12%\n" +
-	"\x0eactive_account\x18\t \x01(\x03R\ractiveAccount\"I\n" +
+	"\x0eactive_account\x18\t \x01(\x03R\ractiveAccount\x120\n" +
+	"\bpayloads\x18\n" +
+	" \x03(\v2\x14.api.PayloadStatDataR\bpayloads\x12\x1e\n" +
+	"\n" +
+	"sortitions\x18\v \x01(\x03R\n" +
+	"sortitions\x12%\n" +
+	"\x0ecommittee_size\x18\f \x01(\x03R\rcommitteeSize\x12-\n" +
+	"\x12committee_turnover\x18\r \x01(\x03R\x11committeeTurnover\x12\x1f\n" +
+	"\vcert_signed\x18\x0e \x01(\x03R\n" +
+	"certSigned\x12\x1f\n" +
+	"\vcert_missed\x18\x0f \x01(\x03R\n" +
+	"certMissed\x12$\n" +
+	"\rparticipation\x18\x10 \x01(\x01R\rparticipation\x12\x16\n" +
+	"\x06reward\x18\x11 \x01(\x03R\x06reward\x12\x14\n" +
+	"\x05yield\x18\x12 \x01(\x01R\x05yield\"\xda\x01\n" +
+	"\x0fPayloadStatData\x12!\n" +
+	"\fpayload_type\x18\x01 \x01(\x05R\vpayloadType\x12\x10\n" +
+	"\x03txs\x18\x02 \x01(\x03R\x03txs\x12\x16\n" +
+	"\x06volume\x18\x03 \x01(\x03R\x06volume\x12\x10\n" +
+	"\x03fee\x18\x04 \x01(\x03R\x03fee\x12\x17\n" +
+	"\afee_min\x18\x05 \x01(\x03R\x06feeMin\x12\x1d\n" +
+	"\n" +
+	"fee_median\x18\x06 \x01(\x03R\tfeeMedian\x12\x17\n" +
+	"\afee_p90\x18\a \x01(\x03R\x06feeP90\x12\x17\n" +
+	"\afee_max\x18\b \x01(\x03R\x06feeMax\"I\n" +
 	"\x17GetNetworkHealthRequest\x12\x12\n" +
 	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
 	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"n\n" +
 	"\x18GetNetworkHealthResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
 	"\x03msg\x18\x02 \x01(\tR\x03msg\x12,\n" +
-	"\x05lines\x18\x03 \x03(\v2\x16.api.NetworkStatusDataR\x05linesB3Z1github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+	"\x05lines\x18\x03 \x03(\v2\x16.api.NetworkStatusDataR\x05lines\"\x88\x01\n" +
+	"\x12DistinctActiveData\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\rR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\rR\x02to\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x03R\x04days\x12\x1a\n" +
+	"\baccounts\x18\x04 \x01(\x03R\baccounts\x12\x1e\n" +
+	"\n" +
+	"validators\x18\x05 \x01(\x03R\n" +
+	"validators\"n\n" +
+	"\x18GetDistinctActiveRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\rR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\rR\x02to\x12\x1a\n" +
+	"\bdatatype\x18\x04 \x01(\tR\bdatatype\"n\n" +
+	"\x19GetDistinctActiveResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.api.DistinctActiveDataR\x04dataB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
 
 var (
 	file_api_blockchain_proto_rawDescOnce sync.Once
@@ -278,19 +697,25 @@ func file_api_blockchain_proto_rawDescGZIP() []byte {
 	return file_api_blockchain_proto_rawDescData
 }
 
-var file_api_blockchain_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_blockchain_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_blockchain_proto_goTypes = []any{
-	(*NetworkStatusData)(nil),        // 0: api.NetworkStatusData
-	(*GetNetworkHealthRequest)(nil),  // 1: api.GetNetworkHealthRequest
-	(*GetNetworkHealthResponse)(nil), // 2: api.GetNetworkHealthResponse
+	(*NetworkStatusData)(nil),         // 0: api.NetworkStatusData
+	(*PayloadStatData)(nil),           // 1: api.PayloadStatData
+	(*GetNetworkHealthRequest)(nil),   // 2: api.GetNetworkHealthRequest
+	(*GetNetworkHealthResponse)(nil),  // 3: api.GetNetworkHealthResponse
+	(*DistinctActiveData)(nil),        // 4: api.DistinctActiveData
+	(*GetDistinctActiveRequest)(nil),  // 5: api.GetDistinctActiveRequest
+	(*GetDistinctActiveResponse)(nil), // 6: api.GetDistinctActiveResponse
 }
 var file_api_blockchain_proto_depIdxs = []int32{
-	0, // 0: api.GetNetworkHealthResponse.lines:type_name -> api.NetworkStatusData
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: api.NetworkStatusData.payloads:type_name -> api.PayloadStatData
+	0, // 1: api.GetNetworkHealthResponse.lines:type_name -> api.NetworkStatusData
+	4, // 2: api.GetDistinctActiveResponse.data:type_name -> api.DistinctActiveData
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_blockchain_proto_init() }
@@ -304,7 +729,7 @@ func file_api_blockchain_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_blockchain_proto_rawDesc), len(file_api_blockchain_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
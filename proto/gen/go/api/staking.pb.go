@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/staking.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakingYieldLineData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Reward        int64                  `protobuf:"varint,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	Stake         int64                  `protobuf:"varint,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	Yield         float64                `protobuf:"fixed64,4,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakingYieldLineData) Reset() {
+	*x = StakingYieldLineData{}
+	mi := &file_api_staking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakingYieldLineData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingYieldLineData) ProtoMessage() {}
+
+func (x *StakingYieldLineData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingYieldLineData.ProtoReflect.Descriptor instead.
+func (*StakingYieldLineData) Descriptor() ([]byte, []int) {
+	return file_api_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StakingYieldLineData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *StakingYieldLineData) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *StakingYieldLineData) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *StakingYieldLineData) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+// network-wide staking yield over a window of days, yields are annualised
+// percentages
+type StakingYieldData struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Days          int64                   `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Reward        int64                   `protobuf:"varint,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	AvgStake      int64                   `protobuf:"varint,3,opt,name=avg_stake,json=avgStake,proto3" json:"avg_stake,omitempty"`
+	Yield         float64                 `protobuf:"fixed64,4,opt,name=yield,proto3" json:"yield,omitempty"`
+	Lines         []*StakingYieldLineData `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakingYieldData) Reset() {
+	*x = StakingYieldData{}
+	mi := &file_api_staking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakingYieldData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingYieldData) ProtoMessage() {}
+
+func (x *StakingYieldData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingYieldData.ProtoReflect.Descriptor instead.
+func (*StakingYieldData) Descriptor() ([]byte, []int) {
+	return file_api_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StakingYieldData) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *StakingYieldData) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *StakingYieldData) GetAvgStake() int64 {
+	if x != nil {
+		return x.AvgStake
+	}
+	return 0
+}
+
+func (x *StakingYieldData) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+func (x *StakingYieldData) GetLines() []*StakingYieldLineData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetStakingYieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Stake         int64                  `protobuf:"varint,2,opt,name=stake,proto3" json:"stake,omitempty" form:"stake"`         // @gotags: form:"stake"
+	Datatype      string                 `protobuf:"bytes,3,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStakingYieldRequest) Reset() {
+	*x = GetStakingYieldRequest{}
+	mi := &file_api_staking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStakingYieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakingYieldRequest) ProtoMessage() {}
+
+func (x *GetStakingYieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakingYieldRequest.ProtoReflect.Descriptor instead.
+func (*GetStakingYieldRequest) Descriptor() ([]byte, []int) {
+	return file_api_staking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStakingYieldRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetStakingYieldRequest) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *GetStakingYieldRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetStakingYieldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg   string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Data  *StakingYieldData      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// reward projected for the requested stake at the yield of the window
+	ProjectedYearlyReward int64 `protobuf:"varint,4,opt,name=projected_yearly_reward,json=projectedYearlyReward,proto3" json:"projected_yearly_reward,omitempty"`
+	ProjectedDailyReward  int64 `protobuf:"varint,5,opt,name=projected_daily_reward,json=projectedDailyReward,proto3" json:"projected_daily_reward,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetStakingYieldResponse) Reset() {
+	*x = GetStakingYieldResponse{}
+	mi := &file_api_staking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStakingYieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakingYieldResponse) ProtoMessage() {}
+
+func (x *GetStakingYieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_staking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakingYieldResponse.ProtoReflect.Descriptor instead.
+func (*GetStakingYieldResponse) Descriptor() ([]byte, []int) {
+	return file_api_staking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStakingYieldResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetStakingYieldResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetStakingYieldResponse) GetData() *StakingYieldData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetStakingYieldResponse) GetProjectedYearlyReward() int64 {
+	if x != nil {
+		return x.ProjectedYearlyReward
+	}
+	return 0
+}
+
+func (x *GetStakingYieldResponse) GetProjectedDailyReward() int64 {
+	if x != nil {
+		return x.ProjectedDailyReward
+	}
+	return 0
+}
+
+var File_api_staking_proto protoreflect.FileDescriptor
+
+const file_api_staking_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/staking.proto\x12\x03api\"y\n" +
+	"\x14StakingYieldLineData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x16\n" +
+	"\x06reward\x18\x02 \x01(\x03R\x06reward\x12\x14\n" +
+	"\x05stake\x18\x03 \x01(\x03R\x05stake\x12\x14\n" +
+	"\x05yield\x18\x04 \x01(\x01R\x05yield\"\xa2\x01\n" +
+	"\x10StakingYieldData\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x03R\x04days\x12\x16\n" +
+	"\x06reward\x18\x02 \x01(\x03R\x06reward\x12\x1b\n" +
+	"\tavg_stake\x18\x03 \x01(\x03R\bavgStake\x12\x14\n" +
+	"\x05yield\x18\x04 \x01(\x01R\x05yield\x12/\n" +
+	"\x05lines\x18\x05 \x03(\v2\x19.api.StakingYieldLineDataR\x05lines\"^\n" +
+	"\x16GetStakingYieldRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05stake\x18\x02 \x01(\x03R\x05stake\x12\x1a\n" +
+	"\bdatatype\x18\x03 \x01(\tR\bdatatype\"\xd8\x01\n" +
+	"\x17GetStakingYieldResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12)\n" +
+	"\x04data\x18\x03 \x01(\v2\x15.api.StakingYieldDataR\x04data\x126\n" +
+	"\x17projected_yearly_reward\x18\x04 \x01(\x03R\x15projectedYearlyReward\x124\n" +
+	"\x16projected_daily_reward\x18\x05 \x01(\x03R\x14projectedDailyRewardB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_staking_proto_rawDescOnce sync.Once
+	file_api_staking_proto_rawDescData []byte
+)
+
+func file_api_staking_proto_rawDescGZIP() []byte {
+	file_api_staking_proto_rawDescOnce.Do(func() {
+		file_api_staking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_staking_proto_rawDesc), len(file_api_staking_proto_rawDesc)))
+	})
+	return file_api_staking_proto_rawDescData
+}
+
+var file_api_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_staking_proto_goTypes = []any{
+	(*StakingYieldLineData)(nil),    // 0: api.StakingYieldLineData
+	(*StakingYieldData)(nil),        // 1: api.StakingYieldData
+	(*GetStakingYieldRequest)(nil),  // 2: api.GetStakingYieldRequest
+	(*GetStakingYieldResponse)(nil), // 3: api.GetStakingYieldResponse
+}
+var file_api_staking_proto_depIdxs = []int32{
+	0, // 0: api.StakingYieldData.lines:type_name -> api.StakingYieldLineData
+	1, // 1: api.GetStakingYieldResponse.data:type_name -> api.StakingYieldData
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_staking_proto_init() }
+func file_api_staking_proto_init() {
+	if File_api_staking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_staking_proto_rawDesc), len(file_api_staking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_staking_proto_goTypes,
+		DependencyIndexes: file_api_staking_proto_depIdxs,
+		MessageInfos:      file_api_staking_proto_msgTypes,
+	}.Build()
+	File_api_staking_proto = out.File
+	file_api_staking_proto_goTypes = nil
+	file_api_staking_proto_depIdxs = nil
+}
@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/unbond.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UnbondForecastData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Validators    int64                  `protobuf:"varint,3,opt,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbondForecastData) Reset() {
+	*x = UnbondForecastData{}
+	mi := &file_api_unbond_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondForecastData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondForecastData) ProtoMessage() {}
+
+func (x *UnbondForecastData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_unbond_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondForecastData.ProtoReflect.Descriptor instead.
+func (*UnbondForecastData) Descriptor() ([]byte, []int) {
+	return file_api_unbond_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UnbondForecastData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *UnbondForecastData) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UnbondForecastData) GetValidators() int64 {
+	if x != nil {
+		return x.Validators
+	}
+	return 0
+}
+
+type GetUnbondForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnbondForecastRequest) Reset() {
+	*x = GetUnbondForecastRequest{}
+	mi := &file_api_unbond_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnbondForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnbondForecastRequest) ProtoMessage() {}
+
+func (x *GetUnbondForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_unbond_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnbondForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetUnbondForecastRequest) Descriptor() ([]byte, []int) {
+	return file_api_unbond_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUnbondForecastRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetUnbondForecastRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetUnbondForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Withdrawable  int64                  `protobuf:"varint,3,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	Lines         []*UnbondForecastData  `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnbondForecastResponse) Reset() {
+	*x = GetUnbondForecastResponse{}
+	mi := &file_api_unbond_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnbondForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnbondForecastResponse) ProtoMessage() {}
+
+func (x *GetUnbondForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_unbond_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnbondForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetUnbondForecastResponse) Descriptor() ([]byte, []int) {
+	return file_api_unbond_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUnbondForecastResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetUnbondForecastResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUnbondForecastResponse) GetWithdrawable() int64 {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return 0
+}
+
+func (x *GetUnbondForecastResponse) GetLines() []*UnbondForecastData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_api_unbond_proto protoreflect.FileDescriptor
+
+const file_api_unbond_proto_rawDesc = "" +
+	"\n" +
+	"\x10api/unbond.proto\x12\x03api\"k\n" +
+	"\x12UnbondForecastData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1e\n" +
+	"\n" +
+	"validators\x18\x03 \x01(\x03R\n" +
+	"validators\"J\n" +
+	"\x18GetUnbondForecastRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"\x94\x01\n" +
+	"\x19GetUnbondForecastResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12\"\n" +
+	"\fwithdrawable\x18\x03 \x01(\x03R\fwithdrawable\x12-\n" +
+	"\x05lines\x18\x04 \x03(\v2\x17.api.UnbondForecastDataR\x05linesB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_unbond_proto_rawDescOnce sync.Once
+	file_api_unbond_proto_rawDescData []byte
+)
+
+func file_api_unbond_proto_rawDescGZIP() []byte {
+	file_api_unbond_proto_rawDescOnce.Do(func() {
+		file_api_unbond_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_unbond_proto_rawDesc), len(file_api_unbond_proto_rawDesc)))
+	})
+	return file_api_unbond_proto_rawDescData
+}
+
+var file_api_unbond_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_unbond_proto_goTypes = []any{
+	(*UnbondForecastData)(nil),        // 0: api.UnbondForecastData
+	(*GetUnbondForecastRequest)(nil),  // 1: api.GetUnbondForecastRequest
+	(*GetUnbondForecastResponse)(nil), // 2: api.GetUnbondForecastResponse
+}
+var file_api_unbond_proto_depIdxs = []int32{
+	0, // 0: api.GetUnbondForecastResponse.lines:type_name -> api.UnbondForecastData
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_unbond_proto_init() }
+func file_api_unbond_proto_init() {
+	if File_api_unbond_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_unbond_proto_rawDesc), len(file_api_unbond_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_unbond_proto_goTypes,
+		DependencyIndexes: file_api_unbond_proto_depIdxs,
+		MessageInfos:      file_api_unbond_proto_msgTypes,
+	}.Build()
+	File_api_unbond_proto = out.File
+	file_api_unbond_proto_goTypes = nil
+	file_api_unbond_proto_depIdxs = nil
+}
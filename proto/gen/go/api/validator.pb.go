@@ -0,0 +1,1138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/validator.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidatorStatData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TimeIndex       uint32                 `protobuf:"varint,2,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	BlocksProposed  int64                  `protobuf:"varint,3,opt,name=blocks_proposed,json=blocksProposed,proto3" json:"blocks_proposed,omitempty"`
+	Reward          int64                  `protobuf:"varint,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	Stake           int64                  `protobuf:"varint,5,opt,name=stake,proto3" json:"stake,omitempty"`
+	RewardReceivers []string               `protobuf:"bytes,6,rep,name=reward_receivers,json=rewardReceivers,proto3" json:"reward_receivers,omitempty"`
+	Sortitions      int64                  `protobuf:"varint,7,opt,name=sortitions,proto3" json:"sortitions,omitempty"`
+	CertsSigned     int64                  `protobuf:"varint,8,opt,name=certs_signed,json=certsSigned,proto3" json:"certs_signed,omitempty"`
+	CertsMissed     int64                  `protobuf:"varint,9,opt,name=certs_missed,json=certsMissed,proto3" json:"certs_missed,omitempty"`
+	Yield           float64                `protobuf:"fixed64,10,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidatorStatData) Reset() {
+	*x = ValidatorStatData{}
+	mi := &file_api_validator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorStatData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatData) ProtoMessage() {}
+
+func (x *ValidatorStatData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatData.ProtoReflect.Descriptor instead.
+func (*ValidatorStatData) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorStatData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorStatData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetBlocksProposed() int64 {
+	if x != nil {
+		return x.BlocksProposed
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetStake() int64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetRewardReceivers() []string {
+	if x != nil {
+		return x.RewardReceivers
+	}
+	return nil
+}
+
+func (x *ValidatorStatData) GetSortitions() int64 {
+	if x != nil {
+		return x.Sortitions
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetCertsSigned() int64 {
+	if x != nil {
+		return x.CertsSigned
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetCertsMissed() int64 {
+	if x != nil {
+		return x.CertsMissed
+	}
+	return 0
+}
+
+func (x *ValidatorStatData) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+type ValidatorUptimeData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CertsSigned   int64                  `protobuf:"varint,2,opt,name=certs_signed,json=certsSigned,proto3" json:"certs_signed,omitempty"`
+	CertsMissed   int64                  `protobuf:"varint,3,opt,name=certs_missed,json=certsMissed,proto3" json:"certs_missed,omitempty"`
+	Uptime        float64                `protobuf:"fixed64,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorUptimeData) Reset() {
+	*x = ValidatorUptimeData{}
+	mi := &file_api_validator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorUptimeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorUptimeData) ProtoMessage() {}
+
+func (x *ValidatorUptimeData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorUptimeData.ProtoReflect.Descriptor instead.
+func (*ValidatorUptimeData) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorUptimeData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorUptimeData) GetCertsSigned() int64 {
+	if x != nil {
+		return x.CertsSigned
+	}
+	return 0
+}
+
+func (x *ValidatorUptimeData) GetCertsMissed() int64 {
+	if x != nil {
+		return x.CertsMissed
+	}
+	return 0
+}
+
+func (x *ValidatorUptimeData) GetUptime() float64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+type GetValidatorLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`                         // @gotags: form:"days"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`                      // @gotags: form:"limit"
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty" form:"order_by"` // @gotags: form:"order_by"
+	Datatype      string                 `protobuf:"bytes,4,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"`              // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorLeaderboardRequest) Reset() {
+	*x = GetValidatorLeaderboardRequest{}
+	mi := &file_api_validator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetValidatorLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetValidatorLeaderboardRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetValidatorLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetValidatorLeaderboardRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetValidatorLeaderboardRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetValidatorLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Validators    []*ValidatorStatData   `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorLeaderboardResponse) Reset() {
+	*x = GetValidatorLeaderboardResponse{}
+	mi := &file_api_validator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetValidatorLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetValidatorLeaderboardResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetValidatorLeaderboardResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetValidatorLeaderboardResponse) GetValidators() []*ValidatorStatData {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type GetValidatorHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorHistoryRequest) Reset() {
+	*x = GetValidatorHistoryRequest{}
+	mi := &file_api_validator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorHistoryRequest) ProtoMessage() {}
+
+func (x *GetValidatorHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetValidatorHistoryRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetValidatorHistoryRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetValidatorHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*ValidatorStatData   `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorHistoryResponse) Reset() {
+	*x = GetValidatorHistoryResponse{}
+	mi := &file_api_validator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorHistoryResponse) ProtoMessage() {}
+
+func (x *GetValidatorHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetValidatorHistoryResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetValidatorHistoryResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetValidatorHistoryResponse) GetLines() []*ValidatorStatData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetValidatorUptimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`                         // @gotags: form:"days"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`                      // @gotags: form:"limit"
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty" form:"order_by"` // @gotags: form:"order_by"
+	Datatype      string                 `protobuf:"bytes,4,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"`              // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorUptimeRequest) Reset() {
+	*x = GetValidatorUptimeRequest{}
+	mi := &file_api_validator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorUptimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorUptimeRequest) ProtoMessage() {}
+
+func (x *GetValidatorUptimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorUptimeRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorUptimeRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetValidatorUptimeRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetValidatorUptimeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetValidatorUptimeRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetValidatorUptimeRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetValidatorUptimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Validators    []*ValidatorUptimeData `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorUptimeResponse) Reset() {
+	*x = GetValidatorUptimeResponse{}
+	mi := &file_api_validator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorUptimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorUptimeResponse) ProtoMessage() {}
+
+func (x *GetValidatorUptimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorUptimeResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorUptimeResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetValidatorUptimeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetValidatorUptimeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetValidatorUptimeResponse) GetValidators() []*ValidatorUptimeData {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+// daily validator set churn
+type ValidatorChurnData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	New           int64                  `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Exited        int64                  `protobuf:"varint,3,opt,name=exited,proto3" json:"exited,omitempty"`
+	Withdrawn     int64                  `protobuf:"varint,4,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Live          int64                  `protobuf:"varint,5,opt,name=live,proto3" json:"live,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorChurnData) Reset() {
+	*x = ValidatorChurnData{}
+	mi := &file_api_validator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorChurnData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorChurnData) ProtoMessage() {}
+
+func (x *ValidatorChurnData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorChurnData.ProtoReflect.Descriptor instead.
+func (*ValidatorChurnData) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidatorChurnData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *ValidatorChurnData) GetNew() int64 {
+	if x != nil {
+		return x.New
+	}
+	return 0
+}
+
+func (x *ValidatorChurnData) GetExited() int64 {
+	if x != nil {
+		return x.Exited
+	}
+	return 0
+}
+
+func (x *ValidatorChurnData) GetWithdrawn() int64 {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return 0
+}
+
+func (x *ValidatorChurnData) GetLive() int64 {
+	if x != nil {
+		return x.Live
+	}
+	return 0
+}
+
+// bond, top_up, unbond or withdraw transaction of a validator
+type ValidatorEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	TimeIndex     uint32                 `protobuf:"varint,2,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Account       string                 `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorEventData) Reset() {
+	*x = ValidatorEventData{}
+	mi := &file_api_validator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorEventData) ProtoMessage() {}
+
+func (x *ValidatorEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorEventData.ProtoReflect.Descriptor instead.
+func (*ValidatorEventData) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidatorEventData) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ValidatorEventData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *ValidatorEventData) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ValidatorEventData) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ValidatorEventData) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ValidatorEventData) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ValidatorEventData) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type GetValidatorChurnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`            // @gotags: form:"days"
+	Datatype      string                 `protobuf:"bytes,2,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorChurnRequest) Reset() {
+	*x = GetValidatorChurnRequest{}
+	mi := &file_api_validator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorChurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorChurnRequest) ProtoMessage() {}
+
+func (x *GetValidatorChurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorChurnRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorChurnRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetValidatorChurnRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetValidatorChurnRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetValidatorChurnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*ValidatorChurnData  `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorChurnResponse) Reset() {
+	*x = GetValidatorChurnResponse{}
+	mi := &file_api_validator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorChurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorChurnResponse) ProtoMessage() {}
+
+func (x *GetValidatorChurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorChurnResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorChurnResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetValidatorChurnResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetValidatorChurnResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetValidatorChurnResponse) GetLines() []*ValidatorChurnData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetValidatorTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Datatype      string                 `protobuf:"bytes,1,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"` // @gotags: form:"datatype"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValidatorTimelineRequest) Reset() {
+	*x = GetValidatorTimelineRequest{}
+	mi := &file_api_validator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorTimelineRequest) ProtoMessage() {}
+
+func (x *GetValidatorTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetValidatorTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetValidatorTimelineRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetValidatorTimelineResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg               string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	BondTimeIndex     uint32                 `protobuf:"varint,3,opt,name=bond_time_index,json=bondTimeIndex,proto3" json:"bond_time_index,omitempty"`
+	UnbondTimeIndex   uint32                 `protobuf:"varint,4,opt,name=unbond_time_index,json=unbondTimeIndex,proto3" json:"unbond_time_index,omitempty"`
+	WithdrawTimeIndex uint32                 `protobuf:"varint,5,opt,name=withdraw_time_index,json=withdrawTimeIndex,proto3" json:"withdraw_time_index,omitempty"`
+	Events            []*ValidatorEventData  `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetValidatorTimelineResponse) Reset() {
+	*x = GetValidatorTimelineResponse{}
+	mi := &file_api_validator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValidatorTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorTimelineResponse) ProtoMessage() {}
+
+func (x *GetValidatorTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_validator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetValidatorTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_validator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetValidatorTimelineResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetValidatorTimelineResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetValidatorTimelineResponse) GetBondTimeIndex() uint32 {
+	if x != nil {
+		return x.BondTimeIndex
+	}
+	return 0
+}
+
+func (x *GetValidatorTimelineResponse) GetUnbondTimeIndex() uint32 {
+	if x != nil {
+		return x.UnbondTimeIndex
+	}
+	return 0
+}
+
+func (x *GetValidatorTimelineResponse) GetWithdrawTimeIndex() uint32 {
+	if x != nil {
+		return x.WithdrawTimeIndex
+	}
+	return 0
+}
+
+func (x *GetValidatorTimelineResponse) GetEvents() []*ValidatorEventData {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_api_validator_proto protoreflect.FileDescriptor
+
+const file_api_validator_proto_rawDesc = "" +
+	"\n" +
+	"\x13api/validator.proto\x12\x03api\"\xca\x02\n" +
+	"\x11ValidatorStatData\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x02 \x01(\rR\ttimeIndex\x12'\n" +
+	"\x0fblocks_proposed\x18\x03 \x01(\x03R\x0eblocksProposed\x12\x16\n" +
+	"\x06reward\x18\x04 \x01(\x03R\x06reward\x12\x14\n" +
+	"\x05stake\x18\x05 \x01(\x03R\x05stake\x12)\n" +
+	"\x10reward_receivers\x18\x06 \x03(\tR\x0frewardReceivers\x12\x1e\n" +
+	"\n" +
+	"sortitions\x18\a \x01(\x03R\n" +
+	"sortitions\x12!\n" +
+	"\fcerts_signed\x18\b \x01(\x03R\vcertsSigned\x12!\n" +
+	"\fcerts_missed\x18\t \x01(\x03R\vcertsMissed\x12\x14\n" +
+	"\x05yield\x18\n" +
+	" \x01(\x01R\x05yield\"\x8d\x01\n" +
+	"\x13ValidatorUptimeData\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12!\n" +
+	"\fcerts_signed\x18\x02 \x01(\x03R\vcertsSigned\x12!\n" +
+	"\fcerts_missed\x18\x03 \x01(\x03R\vcertsMissed\x12\x16\n" +
+	"\x06uptime\x18\x04 \x01(\x01R\x06uptime\"\x81\x01\n" +
+	"\x1eGetValidatorLeaderboardRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x1a\n" +
+	"\bdatatype\x18\x04 \x01(\tR\bdatatype\"\x7f\n" +
+	"\x1fGetValidatorLeaderboardResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x126\n" +
+	"\n" +
+	"validators\x18\x03 \x03(\v2\x16.api.ValidatorStatDataR\n" +
+	"validators\"L\n" +
+	"\x1aGetValidatorHistoryRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"q\n" +
+	"\x1bGetValidatorHistoryResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12,\n" +
+	"\x05lines\x18\x03 \x03(\v2\x16.api.ValidatorStatDataR\x05lines\"|\n" +
+	"\x19GetValidatorUptimeRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x1a\n" +
+	"\bdatatype\x18\x04 \x01(\tR\bdatatype\"|\n" +
+	"\x1aGetValidatorUptimeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x128\n" +
+	"\n" +
+	"validators\x18\x03 \x03(\v2\x18.api.ValidatorUptimeDataR\n" +
+	"validators\"\x8f\x01\n" +
+	"\x12ValidatorChurnData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x10\n" +
+	"\x03new\x18\x02 \x01(\x03R\x03new\x12\x16\n" +
+	"\x06exited\x18\x03 \x01(\x03R\x06exited\x12\x1c\n" +
+	"\twithdrawn\x18\x04 \x01(\x03R\twithdrawn\x12\x12\n" +
+	"\x04live\x18\x05 \x01(\x03R\x04live\"\xb9\x01\n" +
+	"\x12ValidatorEventData\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x02 \x01(\rR\ttimeIndex\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\x03R\x04time\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x18\n" +
+	"\aaccount\x18\a \x01(\tR\aaccount\"J\n" +
+	"\x18GetValidatorChurnRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bdatatype\x18\x02 \x01(\tR\bdatatype\"p\n" +
+	"\x19GetValidatorChurnResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12-\n" +
+	"\x05lines\x18\x03 \x03(\v2\x17.api.ValidatorChurnDataR\x05lines\"9\n" +
+	"\x1bGetValidatorTimelineRequest\x12\x1a\n" +
+	"\bdatatype\x18\x01 \x01(\tR\bdatatype\"\xf9\x01\n" +
+	"\x1cGetValidatorTimelineResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x12&\n" +
+	"\x0fbond_time_index\x18\x03 \x01(\rR\rbondTimeIndex\x12*\n" +
+	"\x11unbond_time_index\x18\x04 \x01(\rR\x0funbondTimeIndex\x12.\n" +
+	"\x13withdraw_time_index\x18\x05 \x01(\rR\x11withdrawTimeIndex\x12/\n" +
+	"\x06events\x18\x06 \x03(\v2\x17.api.ValidatorEventDataR\x06eventsB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_validator_proto_rawDescOnce sync.Once
+	file_api_validator_proto_rawDescData []byte
+)
+
+func file_api_validator_proto_rawDescGZIP() []byte {
+	file_api_validator_proto_rawDescOnce.Do(func() {
+		file_api_validator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_validator_proto_rawDesc), len(file_api_validator_proto_rawDesc)))
+	})
+	return file_api_validator_proto_rawDescData
+}
+
+var file_api_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_validator_proto_goTypes = []any{
+	(*ValidatorStatData)(nil),               // 0: api.ValidatorStatData
+	(*ValidatorUptimeData)(nil),             // 1: api.ValidatorUptimeData
+	(*GetValidatorLeaderboardRequest)(nil),  // 2: api.GetValidatorLeaderboardRequest
+	(*GetValidatorLeaderboardResponse)(nil), // 3: api.GetValidatorLeaderboardResponse
+	(*GetValidatorHistoryRequest)(nil),      // 4: api.GetValidatorHistoryRequest
+	(*GetValidatorHistoryResponse)(nil),     // 5: api.GetValidatorHistoryResponse
+	(*GetValidatorUptimeRequest)(nil),       // 6: api.GetValidatorUptimeRequest
+	(*GetValidatorUptimeResponse)(nil),      // 7: api.GetValidatorUptimeResponse
+	(*ValidatorChurnData)(nil),              // 8: api.ValidatorChurnData
+	(*ValidatorEventData)(nil),              // 9: api.ValidatorEventData
+	(*GetValidatorChurnRequest)(nil),        // 10: api.GetValidatorChurnRequest
+	(*GetValidatorChurnResponse)(nil),       // 11: api.GetValidatorChurnResponse
+	(*GetValidatorTimelineRequest)(nil),     // 12: api.GetValidatorTimelineRequest
+	(*GetValidatorTimelineResponse)(nil),    // 13: api.GetValidatorTimelineResponse
+}
+var file_api_validator_proto_depIdxs = []int32{
+	0, // 0: api.GetValidatorLeaderboardResponse.validators:type_name -> api.ValidatorStatData
+	0, // 1: api.GetValidatorHistoryResponse.lines:type_name -> api.ValidatorStatData
+	1, // 2: api.GetValidatorUptimeResponse.validators:type_name -> api.ValidatorUptimeData
+	8, // 3: api.GetValidatorChurnResponse.lines:type_name -> api.ValidatorChurnData
+	9, // 4: api.GetValidatorTimelineResponse.events:type_name -> api.ValidatorEventData
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_validator_proto_init() }
+func file_api_validator_proto_init() {
+	if File_api_validator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_validator_proto_rawDesc), len(file_api_validator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_validator_proto_goTypes,
+		DependencyIndexes: file_api_validator_proto_depIdxs,
+		MessageInfos:      file_api_validator_proto_msgTypes,
+	}.Build()
+	File_api_validator_proto = out.File
+	file_api_validator_proto_goTypes = nil
+	file_api_validator_proto_depIdxs = nil
+}
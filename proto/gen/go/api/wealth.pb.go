@@ -0,0 +1,591 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/wealth.proto
+
+package api
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RichListEntryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Share         float64                `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RichListEntryData) Reset() {
+	*x = RichListEntryData{}
+	mi := &file_api_wealth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RichListEntryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RichListEntryData) ProtoMessage() {}
+
+func (x *RichListEntryData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RichListEntryData.ProtoReflect.Descriptor instead.
+func (*RichListEntryData) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RichListEntryData) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RichListEntryData) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *RichListEntryData) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RichListEntryData) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type WealthBucketData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinBalance    int64                  `protobuf:"varint,1,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
+	Accounts      int64                  `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WealthBucketData) Reset() {
+	*x = WealthBucketData{}
+	mi := &file_api_wealth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WealthBucketData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WealthBucketData) ProtoMessage() {}
+
+func (x *WealthBucketData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WealthBucketData.ProtoReflect.Descriptor instead.
+func (*WealthBucketData) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WealthBucketData) GetMinBalance() int64 {
+	if x != nil {
+		return x.MinBalance
+	}
+	return 0
+}
+
+func (x *WealthBucketData) GetAccounts() int64 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *WealthBucketData) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type WealthDistributionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeIndex     uint32                 `protobuf:"varint,1,opt,name=time_index,json=timeIndex,proto3" json:"time_index,omitempty"`
+	Accounts      int64                  `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Gini          float64                `protobuf:"fixed64,4,opt,name=gini,proto3" json:"gini,omitempty"`
+	Top10Share    float64                `protobuf:"fixed64,5,opt,name=top10_share,json=top10Share,proto3" json:"top10_share,omitempty"`
+	Top100Share   float64                `protobuf:"fixed64,6,opt,name=top100_share,json=top100Share,proto3" json:"top100_share,omitempty"`
+	Top1000Share  float64                `protobuf:"fixed64,7,opt,name=top1000_share,json=top1000Share,proto3" json:"top1000_share,omitempty"`
+	Buckets       []*WealthBucketData    `protobuf:"bytes,8,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WealthDistributionData) Reset() {
+	*x = WealthDistributionData{}
+	mi := &file_api_wealth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WealthDistributionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WealthDistributionData) ProtoMessage() {}
+
+func (x *WealthDistributionData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WealthDistributionData.ProtoReflect.Descriptor instead.
+func (*WealthDistributionData) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WealthDistributionData) GetTimeIndex() uint32 {
+	if x != nil {
+		return x.TimeIndex
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetAccounts() int64 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetTop10Share() float64 {
+	if x != nil {
+		return x.Top10Share
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetTop100Share() float64 {
+	if x != nil {
+		return x.Top100Share
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetTop1000Share() float64 {
+	if x != nil {
+		return x.Top1000Share
+	}
+	return 0
+}
+
+func (x *WealthDistributionData) GetBuckets() []*WealthBucketData {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetRichListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty" form:"limit"`                                                   // @gotags: form:"limit"
+	ExcludeReserve bool                   `protobuf:"varint,2,opt,name=exclude_reserve,json=excludeReserve,proto3" json:"exclude_reserve,omitempty" form:"exclude_reserve"` // @gotags: form:"exclude_reserve"
+	Datatype       string                 `protobuf:"bytes,3,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"`                                           // @gotags: form:"datatype"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRichListRequest) Reset() {
+	*x = GetRichListRequest{}
+	mi := &file_api_wealth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRichListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRichListRequest) ProtoMessage() {}
+
+func (x *GetRichListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRichListRequest.ProtoReflect.Descriptor instead.
+func (*GetRichListRequest) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRichListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRichListRequest) GetExcludeReserve() bool {
+	if x != nil {
+		return x.ExcludeReserve
+	}
+	return false
+}
+
+func (x *GetRichListRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetRichListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Accounts      []*RichListEntryData   `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRichListResponse) Reset() {
+	*x = GetRichListResponse{}
+	mi := &file_api_wealth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRichListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRichListResponse) ProtoMessage() {}
+
+func (x *GetRichListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRichListResponse.ProtoReflect.Descriptor instead.
+func (*GetRichListResponse) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRichListResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetRichListResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetRichListResponse) GetAccounts() []*RichListEntryData {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type GetWealthDistributionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Days           int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty" form:"days"`                                                      // @gotags: form:"days"
+	ExcludeReserve bool                   `protobuf:"varint,2,opt,name=exclude_reserve,json=excludeReserve,proto3" json:"exclude_reserve,omitempty" form:"exclude_reserve"` // @gotags: form:"exclude_reserve"
+	Datatype       string                 `protobuf:"bytes,3,opt,name=datatype,proto3" json:"datatype,omitempty" form:"datatype"`                                           // @gotags: form:"datatype"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWealthDistributionRequest) Reset() {
+	*x = GetWealthDistributionRequest{}
+	mi := &file_api_wealth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWealthDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWealthDistributionRequest) ProtoMessage() {}
+
+func (x *GetWealthDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWealthDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetWealthDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWealthDistributionRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetWealthDistributionRequest) GetExcludeReserve() bool {
+	if x != nil {
+		return x.ExcludeReserve
+	}
+	return false
+}
+
+func (x *GetWealthDistributionRequest) GetDatatype() string {
+	if x != nil {
+		return x.Datatype
+	}
+	return ""
+}
+
+type GetWealthDistributionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Code          int32                     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Lines         []*WealthDistributionData `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWealthDistributionResponse) Reset() {
+	*x = GetWealthDistributionResponse{}
+	mi := &file_api_wealth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWealthDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWealthDistributionResponse) ProtoMessage() {}
+
+func (x *GetWealthDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wealth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWealthDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetWealthDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_api_wealth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWealthDistributionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetWealthDistributionResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetWealthDistributionResponse) GetLines() []*WealthDistributionData {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_api_wealth_proto protoreflect.FileDescriptor
+
+const file_api_wealth_proto_rawDesc = "" +
+	"\n" +
+	"\x10api/wealth.proto\x12\x03api\"s\n" +
+	"\x11RichListEntryData\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\x01R\x05share\"i\n" +
+	"\x10WealthBucketData\x12\x1f\n" +
+	"\vmin_balance\x18\x01 \x01(\x03R\n" +
+	"minBalance\x12\x1a\n" +
+	"\baccounts\x18\x02 \x01(\x03R\baccounts\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\"\x97\x02\n" +
+	"\x16WealthDistributionData\x12\x1d\n" +
+	"\n" +
+	"time_index\x18\x01 \x01(\rR\ttimeIndex\x12\x1a\n" +
+	"\baccounts\x18\x02 \x01(\x03R\baccounts\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04gini\x18\x04 \x01(\x01R\x04gini\x12\x1f\n" +
+	"\vtop10_share\x18\x05 \x01(\x01R\n" +
+	"top10Share\x12!\n" +
+	"\ftop100_share\x18\x06 \x01(\x01R\vtop100Share\x12#\n" +
+	"\rtop1000_share\x18\a \x01(\x01R\ftop1000Share\x12/\n" +
+	"\abuckets\x18\b \x03(\v2\x15.api.WealthBucketDataR\abuckets\"o\n" +
+	"\x12GetRichListRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12'\n" +
+	"\x0fexclude_reserve\x18\x02 \x01(\bR\x0eexcludeReserve\x12\x1a\n" +
+	"\bdatatype\x18\x03 \x01(\tR\bdatatype\"o\n" +
+	"\x13GetRichListResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x122\n" +
+	"\baccounts\x18\x03 \x03(\v2\x16.api.RichListEntryDataR\baccounts\"w\n" +
+	"\x1cGetWealthDistributionRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12'\n" +
+	"\x0fexclude_reserve\x18\x02 \x01(\bR\x0eexcludeReserve\x12\x1a\n" +
+	"\bdatatype\x18\x03 \x01(\tR\bdatatype\"x\n" +
+	"\x1dGetWealthDistributionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x10\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\x121\n" +
+	"\x05lines\x18\x03 \x03(\v2\x1b.api.WealthDistributionDataR\x05linesB4Z2github.com/1pactus/1pactus-react/backend/proto/apib\x06proto3"
+
+var (
+	file_api_wealth_proto_rawDescOnce sync.Once
+	file_api_wealth_proto_rawDescData []byte
+)
+
+func file_api_wealth_proto_rawDescGZIP() []byte {
+	file_api_wealth_proto_rawDescOnce.Do(func() {
+		file_api_wealth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_wealth_proto_rawDesc), len(file_api_wealth_proto_rawDesc)))
+	})
+	return file_api_wealth_proto_rawDescData
+}
+
+var file_api_wealth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_wealth_proto_goTypes = []any{
+	(*RichListEntryData)(nil),             // 0: api.RichListEntryData
+	(*WealthBucketData)(nil),              // 1: api.WealthBucketData
+	(*WealthDistributionData)(nil),        // 2: api.WealthDistributionData
+	(*GetRichListRequest)(nil),            // 3: api.GetRichListRequest
+	(*GetRichListResponse)(nil),           // 4: api.GetRichListResponse
+	(*GetWealthDistributionRequest)(nil),  // 5: api.GetWealthDistributionRequest
+	(*GetWealthDistributionResponse)(nil), // 6: api.GetWealthDistributionResponse
+}
+var file_api_wealth_proto_depIdxs = []int32{
+	1, // 0: api.WealthDistributionData.buckets:type_name -> api.WealthBucketData
+	0, // 1: api.GetRichListResponse.accounts:type_name -> api.RichListEntryData
+	2, // 2: api.GetWealthDistributionResponse.lines:type_name -> api.WealthDistributionData
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_wealth_proto_init() }
+func file_api_wealth_proto_init() {
+	if File_api_wealth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_wealth_proto_rawDesc), len(file_api_wealth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_wealth_proto_goTypes,
+		DependencyIndexes: file_api_wealth_proto_depIdxs,
+		MessageInfos:      file_api_wealth_proto_msgTypes,
+	}.Build()
+	File_api_wealth_proto = out.File
+	file_api_wealth_proto_goTypes = nil
+	file_api_wealth_proto_depIdxs = nil
+}
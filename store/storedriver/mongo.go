@@ -19,6 +19,8 @@ import (
 type IMongoStore interface {
 	Init(store Mongo, conf *config.MongoConfig)
 	Indexes() map[*mongo.Collection][]mongo.IndexModel
+	// Seed writes the initial documents once the indexes exist
+	Seed() error
 }
 
 type Mongo interface {
@@ -26,6 +28,12 @@ type Mongo interface {
 	GetTimeout() time.Duration
 }
 
+// MongoConn is a connection opened outside of MongoStart
+type MongoConn interface {
+	Mongo
+	Close()
+}
+
 type mongoImpl struct {
 	conf     *config.MongoConfig
 	client   *mongo.Client
@@ -62,6 +70,13 @@ func MongoStart(name string, conf *config.MongoConfig, stores []IMongoStore) err
 			if err := m.ensureIndexes(); err != nil {
 				return fmt.Errorf("mongo [%s] ensure indexes failed: %v", name, err)
 			}
+
+			for _, store := range m.stores {
+				if err := store.Seed(); err != nil {
+					return fmt.Errorf("mongo [%s] seed failed: %v", name, err)
+				}
+			}
+
 			go m.monitorConnection()
 			return nil
 		} else {
@@ -78,6 +93,22 @@ func MongoStart(name string, conf *config.MongoConfig, stores []IMongoStore) err
 	}
 }
 
+// MongoConnect opens a connection without initializing any store, for
+// maintenance such as imports
+func MongoConnect(name string, conf *config.MongoConfig) (MongoConn, error) {
+	m := &mongoImpl{
+		conf:    conf,
+		timeout: time.Second * 10, // Default timeout
+		log:     log.WithKv("module", "store").WithKv("mongo", name),
+	}
+
+	if err := m.connect(); err != nil {
+		return nil, fmt.Errorf("mongo [%s] connect failed: %v", name, err)
+	}
+
+	return m, nil
+}
+
 func (db *mongoImpl) connect() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
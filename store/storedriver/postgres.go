@@ -3,6 +3,9 @@ package storedriver
 import (
 	"context"
 	"fmt"
+	"io/fs"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/1pactus/1pactus-react/config"
@@ -10,44 +13,56 @@ import (
 	"github.com/rs/zerolog"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
 type IPostgresGormStore interface {
 	Init(store GormPostgres)
-	AutoMigrate() error
+	// versioned migrations, see LoadMigrations
+	Migrations() fs.FS
+	// Seed writes the initial data once the schema is at the latest version
+	Seed() error
 	Models() []interface{}
+	// tables and indexes reconciled with the database at startup, see
+	// reconcileSchema
+	Tables() []TableSchema
 	Indexes() []IndexSchema
 }
 
-type TableSchema struct {
-	Name    string
-	DDL     string
-	Indexes []IndexSchema
-}
-
-type IndexSchema struct {
-	Name    string
-	Table   string
-	Columns []string
-	Unique  bool
-}
-
 type GormPostgres interface {
 	GetDB() *gorm.DB
+	// GetReadDB returns a replica for reads that tolerate replication lag,
+	// the primary when no replica is configured or healthy
+	GetReadDB() *gorm.DB
 	GetTimeout() time.Duration
 	WithContext(ctx context.Context) *gorm.DB
 }
 
+// GormPostgresConn is a connection opened outside of PostgresGormStart
+type GormPostgresConn interface {
+	GormPostgres
+	Close()
+}
+
 type postgresGormImpl struct {
 	conf    *config.PostgresConfig
 	db      *gorm.DB
 	stores  []IPostgresGormStore
 	timeout time.Duration
 	log     log.ILogger
+
+	replicas    []*postgresReplica
+	nextReplica atomic.Uint64
+	// closed to stop the replica health checks
+	replicasDone   chan struct{}
+	replicasMu     sync.Mutex
+	replicasClosed bool
 }
 
 func (db *postgresGormImpl) Close() {
+	db.closeReplicas()
+
 	if db.db != nil {
 		db, err := db.db.DB()
 		if err == nil {
@@ -69,16 +84,17 @@ func PostgresGormStart(name string, conf *config.PostgresConfig, stores []IPostg
 
 	for {
 		if err = m.connect(); err == nil {
+			m.connectReplicas()
 			m.initStores()
 
 			m.log.Infof("gorm postgres connect and initialized success")
 
-			if err := m.autoMigrate(); err != nil {
-				return fmt.Errorf("gorm postgres [%s] auto migrate failed: %v", name, err)
+			if err := m.checkSchema(); err != nil {
+				return fmt.Errorf("gorm postgres [%s] schema check failed: %v", name, err)
 			}
 
-			if err := m.ensureIndexes(); err != nil {
-				return fmt.Errorf("gorm postgres [%s] ensure indexes failed: %v", name, err)
+			if err := m.reconcileSchemas(); err != nil {
+				return fmt.Errorf("gorm postgres [%s] schema reconcile failed: %v", name, err)
 			}
 
 			go m.monitorConnection()
@@ -96,14 +112,58 @@ func PostgresGormStart(name string, conf *config.PostgresConfig, stores []IPostg
 	}
 }
 
+// PostgresGormConnect opens a connection without initializing any store, for
+// maintenance such as migrations
+func PostgresGormConnect(name string, conf *config.PostgresConfig) (GormPostgresConn, error) {
+	m := &postgresGormImpl{
+		conf:    conf,
+		timeout: time.Second * 10, // Default timeout
+		log:     log.WithKv("module", "store").WithKv("postgres", name),
+	}
+
+	if err := m.connect(); err != nil {
+		return nil, fmt.Errorf("gorm postgres [%s] connect failed: %v", name, err)
+	}
+
+	return m, nil
+}
+
 func (db *postgresGormImpl) connect() error {
+	gormDB, err := db.open(db.conf.Host, db.conf.Port)
+	if err != nil {
+		return err
+	}
+
+	if db.conf.Schema != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := gormDB.WithContext(ctx).Exec("CREATE SCHEMA IF NOT EXISTS ?", clause.Table{Name: db.conf.Schema}).Error; err != nil {
+			if postgresDb, err := gormDB.DB(); err == nil {
+				postgresDb.Close()
+			}
+			return err
+		}
+	}
+
+	db.db = gormDB
+	return nil
+}
+
+// open connects to a server with the credentials, database, schema and pool
+// settings of the config
+func (db *postgresGormImpl) open(host string, port int) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable TimeZone=UTC",
-		db.conf.Host,
-		db.conf.Port,
+		host,
+		port,
 		db.conf.Username,
 		db.conf.Password,
 		db.conf.Database)
 
+	if db.conf.Schema != "" {
+		dsn += fmt.Sprintf(" search_path=%s", db.conf.Schema)
+	}
+
 	var gormLogLevel logger.LogLevel
 	switch db.log.GetInternalLogger().GetLevel() {
 	case zerolog.DebugLevel:
@@ -121,12 +181,12 @@ func (db *postgresGormImpl) connect() error {
 		},
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	postgresDb, err := gormDB.DB()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	postgresDb.SetMaxOpenConns(db.conf.MaxOpenConns)
@@ -139,11 +199,10 @@ func (db *postgresGormImpl) connect() error {
 
 	if err := postgresDb.PingContext(ctx); err != nil {
 		postgresDb.Close()
-		return err
+		return nil, err
 	}
 
-	db.db = gormDB
-	return nil
+	return gormDB, nil
 }
 
 func (db *postgresGormImpl) initStores() {
@@ -152,71 +211,23 @@ func (db *postgresGormImpl) initStores() {
 	}
 }
 
-func (db *postgresGormImpl) autoMigrate() error {
+// checkSchema refuses a database whose schema version is not the latest
+// migration of a store, then seeds the store
+func (db *postgresGormImpl) checkSchema() error {
 	for _, store := range db.stores {
-		// 使用GORM的AutoMigrate功能
-		if err := store.AutoMigrate(); err != nil {
-			return fmt.Errorf("auto migrate failed for store: %v", err)
+		migrations, err := LoadMigrations(store.Migrations())
+		if err != nil {
+			return fmt.Errorf("load migrations failed: %v", err)
 		}
 
-		// 如果store有自定义的Models方法，也可以使用GORM的AutoMigrate
-		models := store.Models()
-		if len(models) > 0 {
-			if err := db.db.AutoMigrate(models...); err != nil {
-				return fmt.Errorf("auto migrate models failed: %v", err)
-			}
-			db.log.Infof("auto migrated %d models successfully", len(models))
-		}
-	}
-	return nil
-}
-
-func (db *postgresGormImpl) ensureIndexes() error {
-	ctx, cancel := context.WithTimeout(context.Background(), db.timeout)
-	defer cancel()
-
-	for _, store := range db.stores {
-		indexes := store.Indexes()
-		for _, index := range indexes {
-			if err := db.ensureIndex(ctx, index); err != nil {
-				return err
-			}
+		if err := NewPostgresMigrator(db.db, migrations, db.log).Check(); err != nil {
+			return err
 		}
-	}
-	return nil
-}
 
-func (db *postgresGormImpl) ensureIndex(ctx context.Context, index IndexSchema) error {
-	migrator := db.db.Migrator()
-
-	if migrator.HasIndex(index.Table, index.Name) {
-		db.log.Infof("index %s already exists", index.Name)
-		return nil
-	}
-
-	var indexType string
-	if index.Unique {
-		indexType = "UNIQUE INDEX"
-	} else {
-		indexType = "INDEX"
-	}
-
-	columns := ""
-	for i, col := range index.Columns {
-		if i > 0 {
-			columns += ", "
+		if err := store.Seed(); err != nil {
+			return fmt.Errorf("seed failed: %v", err)
 		}
-		columns += fmt.Sprintf("`%s`", col)
 	}
-
-	createIndexSQL := fmt.Sprintf("CREATE %s `%s` ON `%s` (%s)",
-		indexType, index.Name, index.Table, columns)
-
-	if err := db.db.WithContext(ctx).Exec(createIndexSQL).Error; err != nil {
-		return fmt.Errorf("create index %s failed: %v", index.Name, err)
-	}
-
-	db.log.Infof("index %s created successfully", index.Name)
 	return nil
 }
 
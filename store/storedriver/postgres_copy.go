@@ -0,0 +1,127 @@
+package storedriver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// ErrCopyUnavailable is returned by CopyMerge outside of a CopyTransaction or
+// on a database other than postgres
+var ErrCopyUnavailable = errors.New("copy needs a postgres transaction opened by CopyTransaction")
+
+type copyConnKey struct{}
+
+// CopyTransaction runs fn in a transaction pinned to a single connection, so
+// CopyMerge can reach the pgx connection below the transaction
+func CopyTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	session := db.Session(&gorm.Session{Context: context.WithValue(ctx, copyConnKey{}, conn)})
+	session.Statement.ConnPool = conn
+
+	return session.Transaction(fn)
+}
+
+// CopyMerge stages rows in a temporary table with the COPY protocol, then
+// merges them into the table of the model with a single INSERT ... SELECT
+// using onConflict. The rows must not repeat a conflict key.
+func CopyMerge[T any](tx *gorm.DB, rows []*T, onConflict clause.OnConflict) error {
+	conn, ok := tx.Statement.Context.Value(copyConnKey{}).(*sql.Conn)
+	if !ok || tx.Dialector.Name() != "postgres" {
+		return ErrCopyUnavailable
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(rows[0]); err != nil {
+		return err
+	}
+
+	table := stmt.Schema.Table
+	staging := "copy_" + table
+
+	fields := make([]*schema.Field, 0, len(stmt.Schema.DBNames))
+	columns := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		fields = append(fields, stmt.Schema.FieldsByDBName[name])
+		columns = append(columns, name)
+	}
+
+	values := make([][]any, 0, len(rows))
+	for _, row := range rows {
+		rv := reflect.ValueOf(row)
+
+		value := make([]any, 0, len(fields))
+		for _, field := range fields {
+			v, _ := field.ValueOf(tx.Statement.Context, rv)
+			value = append(value, v)
+		}
+
+		values = append(values, value)
+	}
+
+	// the staging table lives until the end of the transaction
+	err := tx.Exec(fmt.Sprintf("CREATE TEMP TABLE IF NOT EXISTS %s (LIKE %s INCLUDING DEFAULTS) ON COMMIT DROP",
+		quoteIdent(staging), quoteIdent(table))).Error
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Exec("TRUNCATE " + quoteIdent(staging)).Error; err != nil {
+		return err
+	}
+
+	err = conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("copy needs a pgx connection, got %T", driverConn)
+		}
+
+		_, err := pgxConn.Conn().CopyFrom(tx.Statement.Context, pgx.Identifier{staging}, columns, pgx.CopyFromRows(values))
+		return err
+	})
+
+	if err != nil {
+		return fmt.Errorf("copy into %s failed: %v", staging, err)
+	}
+
+	if onConflict.UpdateAll {
+		onConflict.DoUpdates = clause.AssignmentColumns(slices.DeleteFunc(slices.Clone(columns), func(name string) bool {
+			return slices.ContainsFunc(onConflict.Columns, func(c clause.Column) bool { return c.Name == name })
+		}))
+	}
+
+	quoted := make([]string, 0, len(columns))
+	for _, name := range columns {
+		quoted = append(quoted, quoteIdent(name))
+	}
+
+	columnList := strings.Join(quoted, ", ")
+
+	// the postgres dialector writes the ON CONFLICT keyword of onConflict
+	return tx.Table(table).Exec(fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s ?",
+		quoteIdent(table), columnList, columnList, quoteIdent(staging)), onConflict).Error
+}
@@ -0,0 +1,327 @@
+package storedriver
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/1pactus/1pactus-react/log"
+	"gorm.io/gorm"
+)
+
+const (
+	SchemaMigrationsTable = "schema_migrations"
+)
+
+// Migration is a versioned schema change read from a NNNN_name.up.sql file
+// and its optional NNNN_name.down.sql counterpart
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus is a known migration and whether it is applied
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt int64
+}
+
+type schemaMigration struct {
+	Version   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"not null"`
+	AppliedAt int64  `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return SchemaMigrationsTable
+}
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// LoadMigrations reads the migrations at the root of fsys ordered by version
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+
+	for _, entry := range entries {
+		matches := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	slices.SortFunc(migrations, func(a, b *Migration) int {
+		return int(a.Version - b.Version)
+	})
+
+	return migrations, nil
+}
+
+var dollarQuoteRegexp = regexp.MustCompile(`\$\w*\$`)
+
+// splitStatements splits a migration file into statements, each ending with a
+// semicolon at the end of a line outside of a dollar-quoted body such as the
+// one of a DO block
+func splitStatements(content string) []string {
+	statements := make([]string, 0)
+	var current strings.Builder
+	quote := ""
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current.Len() == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		for _, tag := range dollarQuoteRegexp.FindAllString(line, -1) {
+			if quote == "" {
+				quote = tag
+			} else if tag == quote {
+				quote = ""
+			}
+		}
+
+		if quote == "" && strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
+
+// PostgresMigrator applies versioned migrations and records them in the
+// schema_migrations table of the current search path
+type PostgresMigrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	log        log.ILogger
+}
+
+func NewPostgresMigrator(db *gorm.DB, migrations []*Migration, log log.ILogger) *PostgresMigrator {
+	return &PostgresMigrator{
+		db:         db,
+		migrations: migrations,
+		log:        log,
+	}
+}
+
+func (m *PostgresMigrator) ensureTable() error {
+	return m.db.Exec(`CREATE TABLE IF NOT EXISTS "` + SchemaMigrationsTable + `" (` +
+		`"version" bigint NOT NULL, "name" text NOT NULL, "applied_at" bigint NOT NULL, PRIMARY KEY ("version"))`).Error
+}
+
+func (m *PostgresMigrator) applied() (map[int64]*schemaMigration, error) {
+	if err := m.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	var rows []*schemaMigration
+	if err := m.db.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]*schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	return applied, nil
+}
+
+// Latest returns the version of the newest known migration
+func (m *PostgresMigrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the newest applied version, 0 for an unmigrated database
+func (m *PostgresMigrator) Version() (int64, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	for v := range applied {
+		version = max(version, v)
+	}
+
+	return version, nil
+}
+
+func (m *PostgresMigrator) Status() ([]*MigrationStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	rets := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := &MigrationStatus{Version: migration.Version, Name: migration.Name}
+
+		if row, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = row.AppliedAt
+		}
+
+		rets = append(rets, status)
+	}
+
+	return rets, nil
+}
+
+// Check returns an error unless the database is at the latest version
+func (m *PostgresMigrator) Check() error {
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	if version < m.Latest() {
+		return fmt.Errorf("schema version %d is behind %d, run `migrate up`", version, m.Latest())
+	}
+
+	if version > m.Latest() {
+		return fmt.Errorf("schema version %d is ahead of %d, the binary is older than the database", version, m.Latest())
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order, each in its own transaction. It
+// returns the number of migrations applied.
+func (m *PostgresMigrator) Up() (int, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			for _, statement := range splitStatements(migration.Up) {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+
+			return tx.Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().Unix(),
+			}).Error
+		})
+
+		if err != nil {
+			return count, fmt.Errorf("migration %d_%s up failed: %w", migration.Version, migration.Name, err)
+		}
+
+		m.log.Infof("migration %d_%s applied", migration.Version, migration.Name)
+		count++
+	}
+
+	return count, nil
+}
+
+// Down reverts up to steps applied migrations, newest first. It returns the
+// number of migrations reverted.
+func (m *PostgresMigrator) Down(steps int) (int, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+		migration := m.migrations[i]
+
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		if migration.Down == "" {
+			return count, fmt.Errorf("migration %d_%s cannot be reverted", migration.Version, migration.Name)
+		}
+
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			for _, statement := range splitStatements(migration.Down) {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+
+			return tx.Where("version = ?", migration.Version).Delete(&schemaMigration{}).Error
+		})
+
+		if err != nil {
+			return count, fmt.Errorf("migration %d_%s down failed: %w", migration.Version, migration.Name, err)
+		}
+
+		m.log.Infof("migration %d_%s reverted", migration.Version, migration.Name)
+		count++
+	}
+
+	return count, nil
+}
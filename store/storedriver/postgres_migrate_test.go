@@ -0,0 +1,67 @@
+package storedriver
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitStatements(t *testing.T) {
+	content := `-- leading comment
+CREATE TABLE "a" (
+    "id" bigint NOT NULL
+);
+
+DO $$
+BEGIN
+    EXECUTE 'SELECT 1;';
+    PERFORM 2;
+END
+$$;
+
+CREATE FUNCTION f() RETURNS text AS $body$
+    SELECT 'x;';
+$$ is not the closing tag;
+$body$ LANGUAGE sql;
+-- a comment between statements
+DROP TABLE "a";
+SELECT 3`
+
+	want := []string{
+		"CREATE TABLE \"a\" (\n    \"id\" bigint NOT NULL\n);",
+		"DO $$\nBEGIN\n    EXECUTE 'SELECT 1;';\n    PERFORM 2;\nEND\n$$;",
+		"CREATE FUNCTION f() RETURNS text AS $body$\n    SELECT 'x;';\n$$ is not the closing tag;\n$body$ LANGUAGE sql;",
+		"DROP TABLE \"a\";",
+		"SELECT 3",
+	}
+
+	got := splitStatements(content)
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("splitStatements returned %d statements:\n%q\nwant:\n%q", len(got), got, want)
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := LoadMigrations(fstest.MapFS{
+		"0002_second.up.sql":  {Data: []byte("SELECT 2;")},
+		"0001_first.up.sql":   {Data: []byte("SELECT 1;")},
+		"0001_first.down.sql": {Data: []byte("SELECT -1;")},
+		"README.md":           {Data: []byte("not a migration")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(migrations) != 2 || migrations[0].Version != 1 || migrations[1].Version != 2 {
+		t.Fatalf("unexpected migrations %+v", migrations)
+	}
+
+	if migrations[0].Name != "first" || migrations[0].Down != "SELECT -1;" || migrations[1].Down != "" {
+		t.Errorf("unexpected first migration %+v", migrations[0])
+	}
+
+	if _, err := LoadMigrations(fstest.MapFS{"0001_first.down.sql": {Data: []byte("SELECT -1;")}}); err == nil {
+		t.Errorf("a migration without up file was loaded")
+	}
+}
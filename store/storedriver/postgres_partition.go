@@ -0,0 +1,305 @@
+package storedriver
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/1pactus/1pactus-react/log"
+	"gorm.io/gorm"
+)
+
+// Partition is a range partition of a table
+type Partition struct {
+	Table string
+	Name  string
+	// bounds of the partition, math.MinInt64 and math.MaxInt64 stand for
+	// MINVALUE and MAXVALUE
+	From int64
+	To   int64
+	// row estimate of the last analyze
+	Rows int64
+}
+
+func (p *Partition) contains(value int64) bool {
+	return p.From <= value && value < p.To
+}
+
+type partitionRow struct {
+	Table string
+	Name  string
+	Bound string
+	Rows  int64
+}
+
+var partitionBoundRegexp = regexp.MustCompile(`FROM \((.+)\) TO \((.+)\)`)
+
+func parseBoundValue(value string) (int64, error) {
+	switch value = strings.Trim(value, "'"); value {
+	case "MINVALUE":
+		return math.MinInt64, nil
+	case "MAXVALUE":
+		return math.MaxInt64, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// monthStart returns the unix time of the UTC month start of timestamp
+func monthStart(timestamp int64) time.Time {
+	year, month, _ := time.Unix(timestamp, 0).UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// PostgresPartitioner creates the monthly range partitions of the tables that
+// declare a PartitionSchema with Monthly set, the partition key being a unix
+// seconds column. Partitions are named <table>_pYYYYMM.
+type PostgresPartitioner struct {
+	db     *gorm.DB
+	tables []TableSchema
+	log    log.ILogger
+
+	mu sync.Mutex
+	// partitions by table, loaded on first use, tables that are not
+	// partitioned in the database are absent
+	partitions map[string][]*Partition
+}
+
+func NewPostgresPartitioner(db *gorm.DB, tables []TableSchema, log log.ILogger) *PostgresPartitioner {
+	monthly := make([]TableSchema, 0)
+	for _, table := range tables {
+		if table.Partition != nil && table.Partition.Monthly {
+			monthly = append(monthly, table)
+		}
+	}
+
+	return &PostgresPartitioner{
+		db:     db,
+		tables: monthly,
+		log:    log,
+	}
+}
+
+func (p *PostgresPartitioner) load() error {
+	if p.partitions != nil || len(p.tables) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(p.tables))
+	for _, table := range p.tables {
+		names = append(names, table.Name)
+	}
+
+	var partitioned []string
+
+	err := p.db.Raw(`SELECT c.relname FROM pg_partitioned_table pt
+		JOIN pg_class c ON c.oid = pt.partrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relname IN ?`, names).
+		Scan(&partitioned).Error
+
+	if err != nil {
+		return err
+	}
+
+	var rows []*partitionRow
+
+	err = p.db.Raw(`SELECT parent.relname AS "table", child.relname AS name,
+		pg_get_expr(child.relpartbound, child.oid) AS bound,
+		GREATEST(child.reltuples, 0)::bigint AS "rows"
+		FROM pg_inherits i
+		JOIN pg_class parent ON parent.oid = i.inhparent
+		JOIN pg_class child ON child.oid = i.inhrelid
+		JOIN pg_namespace n ON n.oid = parent.relnamespace
+		WHERE n.nspname = current_schema() AND parent.relname IN ?`, names).
+		Scan(&rows).Error
+
+	if err != nil {
+		return err
+	}
+
+	partitions := make(map[string][]*Partition, len(partitioned))
+	for _, name := range partitioned {
+		partitions[name] = make([]*Partition, 0)
+	}
+
+	for _, name := range names {
+		if _, ok := partitions[name]; !ok {
+			p.log.Warnf("table %s is not partitioned, its partitions are not managed", name)
+		}
+	}
+
+	for _, row := range rows {
+		matches := partitionBoundRegexp.FindStringSubmatch(row.Bound)
+		if matches == nil {
+			// the default partition
+			continue
+		}
+
+		from, err := parseBoundValue(matches[1])
+		if err != nil {
+			return fmt.Errorf("partition %s: bound %s: %v", row.Name, row.Bound, err)
+		}
+
+		to, err := parseBoundValue(matches[2])
+		if err != nil {
+			return fmt.Errorf("partition %s: bound %s: %v", row.Name, row.Bound, err)
+		}
+
+		partitions[row.Table] = append(partitions[row.Table], &Partition{
+			Table: row.Table,
+			Name:  row.Name,
+			From:  from,
+			To:    to,
+			Rows:  row.Rows,
+		})
+	}
+
+	for _, list := range partitions {
+		slices.SortFunc(list, func(a, b *Partition) int {
+			return cmp.Compare(a.From, b.From)
+		})
+	}
+
+	p.partitions = partitions
+	return nil
+}
+
+// missingPartitions returns the monthly partitions of table for the month of
+// timestamp and the premake months after it, that none of partitions covers
+func missingPartitions(table string, partitions []*Partition, timestamp int64, premake int) []*Partition {
+	missing := make([]*Partition, 0)
+	month := monthStart(timestamp)
+
+	for i := 0; i <= premake; i++ {
+		from, to := month.Unix(), month.AddDate(0, 1, 0).Unix()
+
+		covered := slices.ContainsFunc(partitions, func(partition *Partition) bool {
+			return partition.contains(from)
+		})
+
+		if !covered {
+			missing = append(missing, &Partition{
+				Table: table,
+				Name:  fmt.Sprintf("%s_p%s", table, month.Format("200601")),
+				From:  from,
+				To:    to,
+			})
+		}
+
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return missing
+}
+
+// Ensure creates the partitions of the month of timestamp and the Premake
+// months after it, where no partition covers them yet
+func (p *PostgresPartitioner) Ensure(timestamp int64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.load(); err != nil {
+		return fmt.Errorf("load partitions failed: %v", err)
+	}
+
+	for _, table := range p.tables {
+		partitions, ok := p.partitions[table.Name]
+		if !ok {
+			continue
+		}
+
+		for _, partition := range missingPartitions(table.Name, partitions, timestamp, table.Partition.Premake) {
+			err := p.db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)",
+				quoteIdent(partition.Name), quoteIdent(table.Name), partition.From, partition.To)).Error
+
+			if err != nil {
+				return fmt.Errorf("create partition %s failed: %v", partition.Name, err)
+			}
+
+			p.log.Infof("partition %s created", partition.Name)
+			partitions = append(partitions, partition)
+		}
+
+		p.partitions[table.Name] = partitions
+	}
+
+	return nil
+}
+
+// List returns the partitions of the managed tables ordered by table and range
+func (p *PostgresPartitioner) List() ([]*Partition, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.partitions = nil
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+
+	rets := make([]*Partition, 0)
+	for _, table := range p.tables {
+		rets = append(rets, p.partitions[table.Name]...)
+	}
+
+	return rets, nil
+}
+
+// Detach detaches the partitions that end at or before before, so their rows
+// are no longer read or written through the table. The detached tables are
+// moved to archiveSchema unless it is empty, ready to be dumped or dropped.
+func (p *PostgresPartitioner) Detach(before int64, archiveSchema string) ([]*Partition, error) {
+	partitions, err := p.List()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// detached partitions are reloaded on next use
+	defer func() {
+		p.partitions = nil
+	}()
+
+	if archiveSchema != "" {
+		if err := p.db.Exec("CREATE SCHEMA IF NOT EXISTS " + quoteIdent(archiveSchema)).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	detached := make([]*Partition, 0)
+
+	for _, partition := range partitions {
+		if partition.To > before {
+			continue
+		}
+
+		err := p.db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Exec(fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s",
+				quoteIdent(partition.Table), quoteIdent(partition.Name))).Error
+
+			if err != nil || archiveSchema == "" {
+				return err
+			}
+
+			return tx.Exec(fmt.Sprintf("ALTER TABLE %s SET SCHEMA %s",
+				quoteIdent(partition.Name), quoteIdent(archiveSchema))).Error
+		})
+
+		if err != nil {
+			return detached, fmt.Errorf("detach partition %s failed: %v", partition.Name, err)
+		}
+
+		p.log.Infof("partition %s detached", partition.Name)
+		detached = append(detached, partition)
+	}
+
+	return detached, nil
+}
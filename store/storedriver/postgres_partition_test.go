@@ -0,0 +1,63 @@
+package storedriver
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func unixUTC(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+func TestMissingPartitionsBounds(t *testing.T) {
+	// the last second of 2023-12-31
+	timestamp := unixUTC(2024, time.January, 1) - 1
+
+	missing := missingPartitions("rows", nil, timestamp, 2)
+
+	want := []Partition{
+		{Table: "rows", Name: "rows_p202312", From: unixUTC(2023, time.December, 1), To: unixUTC(2024, time.January, 1)},
+		{Table: "rows", Name: "rows_p202401", From: unixUTC(2024, time.January, 1), To: unixUTC(2024, time.February, 1)},
+		{Table: "rows", Name: "rows_p202402", From: unixUTC(2024, time.February, 1), To: unixUTC(2024, time.March, 1)},
+	}
+
+	if len(missing) != len(want) {
+		t.Fatalf("got %d partitions, want %d", len(missing), len(want))
+	}
+
+	for i, partition := range missing {
+		if *partition != want[i] {
+			t.Errorf("partition %d = %+v, want %+v", i, *partition, want[i])
+		}
+	}
+
+	// consecutive partitions leave no gap
+	for i := 1; i < len(missing); i++ {
+		if missing[i].From != missing[i-1].To {
+			t.Errorf("%s starts at %d, %s ends at %d", missing[i].Name, missing[i].From, missing[i-1].Name, missing[i-1].To)
+		}
+	}
+}
+
+func TestMissingPartitionsSkipsCovered(t *testing.T) {
+	partitions := []*Partition{
+		// the legacy partition of 0002 holds everything before March
+		{Table: "rows", Name: "rows_legacy", From: math.MinInt64, To: unixUTC(2024, time.March, 1)},
+		{Table: "rows", Name: "rows_p202404", From: unixUTC(2024, time.April, 1), To: unixUTC(2024, time.May, 1)},
+	}
+
+	missing := missingPartitions("rows", partitions, unixUTC(2024, time.February, 15), 3)
+
+	if len(missing) != 2 || missing[0].Name != "rows_p202403" || missing[1].Name != "rows_p202405" {
+		t.Fatalf("unexpected partitions %+v", missing)
+	}
+
+	if missing[0].From != unixUTC(2024, time.March, 1) || missing[0].To != unixUTC(2024, time.April, 1) {
+		t.Errorf("rows_p202403 bounds = [%d, %d)", missing[0].From, missing[0].To)
+	}
+
+	if len(missingPartitions("rows", partitions, unixUTC(2024, time.April, 30), 0)) != 0 {
+		t.Errorf("a month covered by a partition was created again")
+	}
+}
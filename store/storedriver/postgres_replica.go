@@ -0,0 +1,215 @@
+package storedriver
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// postgresReplica is a read-only standby of the primary. It serves reads only
+// while its last health check succeeded, it was streaming from the primary and
+// its replay lag was within MaxReplicaLag.
+type postgresReplica struct {
+	host string
+	port int
+
+	db      atomic.Pointer[gorm.DB]
+	healthy atomic.Bool
+	// replay lag in seconds at the last health check
+	lag atomic.Int64
+}
+
+func (r *postgresReplica) String() string {
+	return fmt.Sprintf("%s:%d", r.host, r.port)
+}
+
+// connectReplicas opens the replicas of the config. A replica that cannot be
+// reached is left unhealthy and retried by its health check, reads going to
+// the primary meanwhile.
+func (db *postgresGormImpl) connectReplicas() {
+	db.replicasDone = make(chan struct{})
+
+	for _, conf := range db.conf.Replicas {
+		replica := &postgresReplica{
+			host: conf.Host,
+			port: conf.Port,
+		}
+
+		if replica.port == 0 {
+			replica.port = db.conf.Port
+		}
+
+		db.replicas = append(db.replicas, replica)
+		db.checkReplica(replica)
+
+		if !replica.healthy.Load() {
+			db.log.Warnf("replica %s is not serving reads yet", replica)
+		}
+
+		go db.monitorReplica(replica, db.replicasDone)
+	}
+}
+
+// monitorReplica checks the replica every Healthcheck seconds until done is
+// closed
+func (db *postgresGormImpl) monitorReplica(replica *postgresReplica, done <-chan struct{}) {
+	healthcheck := time.Duration(db.conf.Healthcheck)
+
+	if healthcheck <= 1 {
+		healthcheck = 1
+	}
+
+	ticker := time.NewTicker(healthcheck * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			db.checkReplica(replica)
+		}
+	}
+}
+
+type replicaStatus struct {
+	InRecovery bool
+	Streaming  bool
+	CaughtUp   bool
+	// seconds since the last replayed transaction
+	ReplayAge float64
+}
+
+// checkReplica pings the replica, connecting it first if needed, and measures
+// its replay lag
+func (db *postgresGormImpl) checkReplica(replica *postgresReplica) {
+	gormDB := replica.db.Load()
+
+	if gormDB == nil {
+		var err error
+		if gormDB, err = db.open(replica.host, replica.port); err != nil {
+			db.markReplica(replica, false, fmt.Sprintf("connect failed: %v", err))
+			return
+		}
+
+		if !db.storeReplicaDB(replica, gormDB) {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var status replicaStatus
+
+	// a standby whose wal receiver is down replays nothing new, however little
+	// it has left to replay. The receiver status is only visible with
+	// pg_read_all_stats, a running receiver is trusted without it.
+	err := gormDB.WithContext(ctx).Raw(`SELECT pg_is_in_recovery() AS in_recovery,
+		EXISTS (SELECT 1 FROM pg_stat_wal_receiver WHERE status IS NULL OR status = 'streaming') AS streaming,
+		COALESCE(pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn(), false) AS caught_up,
+		COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) AS replay_age`).Scan(&status).Error
+
+	if err != nil {
+		db.markReplica(replica, false, fmt.Sprintf("health check failed: %v", err))
+		return
+	}
+
+	if status.InRecovery && !status.Streaming {
+		db.markReplica(replica, false, "not streaming from the primary")
+		return
+	}
+
+	// a streaming standby that replayed all it received is as recent as the
+	// primary allows, whatever the age of the last replayed transaction
+	var lag int64
+	if status.InRecovery && !status.CaughtUp {
+		lag = int64(status.ReplayAge)
+	}
+
+	replica.lag.Store(lag)
+
+	if db.conf.MaxReplicaLag > 0 && lag > int64(db.conf.MaxReplicaLag) {
+		db.markReplica(replica, false, fmt.Sprintf("lag %ds over %ds", lag, db.conf.MaxReplicaLag))
+		return
+	}
+
+	db.markReplica(replica, true, "")
+}
+
+// storeReplicaDB sets the connection of a replica, unless the replicas were
+// closed meanwhile, in which case the connection is closed
+func (db *postgresGormImpl) storeReplicaDB(replica *postgresReplica, gormDB *gorm.DB) bool {
+	db.replicasMu.Lock()
+	defer db.replicasMu.Unlock()
+
+	if db.replicasClosed {
+		if sqlDB, err := gormDB.DB(); err == nil {
+			sqlDB.Close()
+		}
+		return false
+	}
+
+	replica.db.Store(gormDB)
+	return true
+}
+
+func (db *postgresGormImpl) markReplica(replica *postgresReplica, healthy bool, reason string) {
+	if replica.healthy.Swap(healthy) == healthy {
+		return
+	}
+
+	if healthy {
+		db.log.Infof("replica %s serves reads, lag %ds", replica, replica.lag.Load())
+	} else {
+		db.log.Warnf("replica %s stops serving reads: %s", replica, reason)
+	}
+}
+
+// closeReplicas stops the health checks and closes the replica connections,
+// no reconnect happens afterwards
+func (db *postgresGormImpl) closeReplicas() {
+	db.replicasMu.Lock()
+	defer db.replicasMu.Unlock()
+
+	if db.replicasClosed {
+		return
+	}
+
+	db.replicasClosed = true
+
+	if db.replicasDone != nil {
+		close(db.replicasDone)
+	}
+
+	for _, replica := range db.replicas {
+		if gormDB := replica.db.Swap(nil); gormDB != nil {
+			if sqlDB, err := gormDB.DB(); err == nil {
+				sqlDB.Close()
+			}
+		}
+	}
+}
+
+// GetReadDB returns a healthy replica in turn, or the primary when there is
+// none. Reads that must see the latest commit use GetDB instead.
+func (db *postgresGormImpl) GetReadDB() *gorm.DB {
+	count := len(db.replicas)
+
+	for i := 0; i < count; i++ {
+		replica := db.replicas[int(db.nextReplica.Add(1)%uint64(count))]
+
+		if !replica.healthy.Load() {
+			continue
+		}
+
+		if gormDB := replica.db.Load(); gormDB != nil {
+			return gormDB
+		}
+	}
+
+	return db.db
+}
@@ -0,0 +1,528 @@
+package storedriver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm/schema"
+)
+
+const (
+	IndexMethodBtree = "btree"
+	IndexMethodBrin  = "brin"
+	IndexMethodGin   = "gin"
+	IndexMethodHash  = "hash"
+
+	PartitionByRange = "RANGE"
+	PartitionByList  = "LIST"
+	PartitionByHash  = "HASH"
+)
+
+// TableSchema declares a table owned by a store. The table is created from DDL
+// when it does not exist, with the PARTITION BY clause of Partition appended,
+// and its indexes are reconciled like the store indexes.
+type TableSchema struct {
+	Name string
+	// CREATE TABLE statement without the PARTITION BY clause, tables created
+	// by migrations leave it empty
+	DDL       string
+	Partition *PartitionSchema
+	Indexes   []IndexSchema
+}
+
+// PartitionSchema is the partition key of a declaratively partitioned table
+type PartitionSchema struct {
+	// PartitionByRange, PartitionByList or PartitionByHash
+	Strategy string
+	Columns  []string
+	// range partitions by month of a unix seconds column, created by the
+	// PostgresPartitioner
+	Monthly bool
+	// months of partitions created ahead of the month written to
+	Premake int
+}
+
+// IndexSchema declares an index. A column that is not a plain identifier is
+// taken as an SQL expression, and a trailing ASC or DESC sets the sort order.
+type IndexSchema struct {
+	Name    string
+	Table   string
+	Columns []string
+	Unique  bool
+	// access method, btree when empty
+	Method string
+	// non-key columns stored in the index so queries can be answered by an
+	// index-only scan
+	Include []string
+	// predicate of a partial index
+	Where string
+}
+
+func (s *PartitionSchema) clause() string {
+	return fmt.Sprintf("PARTITION BY %s (%s)", strings.ToUpper(s.Strategy), joinColumns(s.Columns))
+}
+
+func (i *IndexSchema) method() string {
+	if i.Method == "" {
+		return IndexMethodBtree
+	}
+
+	return strings.ToLower(i.Method)
+}
+
+// DDL returns the CREATE INDEX statement of the index, concurrent unless the
+// table is partitioned which postgres does not support
+func (i *IndexSchema) DDL(concurrently bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("CREATE ")
+	if i.Unique {
+		sb.WriteString("UNIQUE ")
+	}
+	sb.WriteString("INDEX ")
+	if concurrently {
+		sb.WriteString("CONCURRENTLY ")
+	}
+	fmt.Fprintf(&sb, "IF NOT EXISTS %s ON %s USING %s (%s)",
+		quoteIdent(i.Name), quoteIdent(i.Table), i.method(), joinColumns(i.Columns))
+
+	if len(i.Include) > 0 {
+		fmt.Fprintf(&sb, " INCLUDE (%s)", joinColumns(i.Include))
+	}
+
+	if i.Where != "" {
+		fmt.Fprintf(&sb, " WHERE %s", i.Where)
+	}
+
+	return sb.String()
+}
+
+var (
+	identRegexp     = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	sortOrderRegexp = regexp.MustCompile(`(?i)\s+(ASC|DESC)$`)
+	castRegexp      = regexp.MustCompile(`::[a-z0-9_\[\]]+`)
+)
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// indexColumn splits a declared column into its identifier or expression and
+// its sort order
+func indexColumn(column string) (string, string) {
+	column = strings.TrimSpace(column)
+
+	order := ""
+	if loc := sortOrderRegexp.FindStringSubmatchIndex(column); loc != nil {
+		order = strings.ToUpper(column[loc[2]:loc[3]])
+		column = strings.TrimSpace(column[:loc[0]])
+	}
+
+	return column, order
+}
+
+func joinColumns(columns []string) string {
+	parts := make([]string, 0, len(columns))
+
+	for _, c := range columns {
+		column, order := indexColumn(c)
+
+		if identRegexp.MatchString(column) {
+			column = quoteIdent(column)
+		} else {
+			column = "(" + column + ")"
+		}
+
+		if order != "" {
+			column += " " + order
+		}
+
+		parts = append(parts, column)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// normalizeSQL reduces an expression to a form that compares equal to the
+// text postgres deparses it to, which adds parentheses and casts
+func normalizeSQL(expr string) string {
+	return castRegexp.ReplaceAllString(strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\n', '(', ')', '"':
+			return -1
+		}
+		return r
+	}, strings.ToLower(expr)), "")
+}
+
+const (
+	// a declared table is absent and has no DDL to create it
+	DriftTableMissing = "table_missing"
+	// a declared table is partitioned differently
+	DriftPartitionChanged = "partition_changed"
+	// a declared index was absent and has been created
+	DriftIndexCreated = "index_created"
+	// a declared index exists with another definition, it is left in place
+	DriftIndexChanged = "index_changed"
+	// a declared index exists but is invalid, usually an interrupted
+	// concurrent build, and must be dropped to be rebuilt
+	DriftIndexInvalid = "index_invalid"
+	// an index on a declared table is neither declared nor defined by a model
+	DriftIndexUndeclared = "index_undeclared"
+)
+
+// SchemaDrift is a difference between the declared and the existing schema
+type SchemaDrift struct {
+	Kind   string
+	Table  string
+	Index  string
+	Detail string
+}
+
+func (d *SchemaDrift) String() string {
+	name := d.Table
+	if d.Index != "" {
+		name += "." + d.Index
+	}
+
+	if d.Detail == "" {
+		return fmt.Sprintf("%s %s", d.Kind, name)
+	}
+
+	return fmt.Sprintf("%s %s: %s", d.Kind, name, d.Detail)
+}
+
+type existingTable struct {
+	Name         string
+	Partitioned  bool
+	PartitionKey string
+}
+
+type existingIndex struct {
+	Name       string
+	Table      string
+	Method     string
+	Unique     bool
+	Valid      bool
+	KeyColumns int
+	Columns    string
+	Predicate  string
+}
+
+func (e *existingIndex) columns() []string {
+	if e.Columns == "" {
+		return nil
+	}
+
+	return strings.Split(e.Columns, "\n")
+}
+
+// diff compares an existing index with its declaration, ignoring sort orders
+func (e *existingIndex) diff(index *IndexSchema) []string {
+	diffs := make([]string, 0)
+
+	if e.Table != index.Table {
+		diffs = append(diffs, fmt.Sprintf("on table %s", e.Table))
+	}
+
+	if e.Method != index.method() {
+		diffs = append(diffs, fmt.Sprintf("method %s, declared %s", e.Method, index.method()))
+	}
+
+	if e.Unique != index.Unique {
+		diffs = append(diffs, fmt.Sprintf("unique %v, declared %v", e.Unique, index.Unique))
+	}
+
+	columns := e.columns()
+	keys, include := columns[:min(e.KeyColumns, len(columns))], columns[min(e.KeyColumns, len(columns)):]
+
+	if !sameColumns(keys, index.Columns) {
+		diffs = append(diffs, fmt.Sprintf("columns (%s), declared (%s)",
+			strings.Join(keys, ", "), strings.Join(index.Columns, ", ")))
+	}
+
+	if !sameColumns(include, index.Include) {
+		diffs = append(diffs, fmt.Sprintf("include (%s), declared (%s)",
+			strings.Join(include, ", "), strings.Join(index.Include, ", ")))
+	}
+
+	if normalizeSQL(e.Predicate) != normalizeSQL(index.Where) {
+		diffs = append(diffs, fmt.Sprintf("predicate %q, declared %q", e.Predicate, index.Where))
+	}
+
+	return diffs
+}
+
+func sameColumns(existing []string, declared []string) bool {
+	if len(existing) != len(declared) {
+		return false
+	}
+
+	for i := range existing {
+		column, _ := indexColumn(declared[i])
+		if normalizeSQL(existing[i]) != normalizeSQL(column) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (db *postgresGormImpl) existingTables(ctx context.Context) (map[string]*existingTable, error) {
+	var rows []*existingTable
+
+	err := db.db.WithContext(ctx).Raw(`SELECT c.relname AS name, c.relkind = 'p' AS partitioned,
+		CASE WHEN c.relkind = 'p' THEN pg_get_partkeydef(c.oid) ELSE '' END AS partition_key
+		FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relkind IN ('r', 'p') AND NOT c.relispartition`).
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string]*existingTable, len(rows))
+	for _, row := range rows {
+		tables[row.Name] = row
+	}
+
+	return tables, nil
+}
+
+// existingIndexes lists the indexes of the current schema that do not back a
+// constraint such as a primary key, keyed by name as index names are unique
+// within a schema
+func (db *postgresGormImpl) existingIndexes(ctx context.Context) (map[string]*existingIndex, error) {
+	var rows []*existingIndex
+
+	err := db.db.WithContext(ctx).Raw(`SELECT i.relname AS name, t.relname AS "table", am.amname AS method,
+		ix.indisunique AS "unique", ix.indisvalid AS valid, ix.indnkeyatts AS key_columns,
+		array_to_string(ARRAY(SELECT pg_get_indexdef(ix.indexrelid, k, true) FROM generate_series(1, ix.indnatts) AS k ORDER BY k), E'\n') AS columns,
+		COALESCE(pg_get_expr(ix.indpred, ix.indrelid, true), '') AS predicate
+		FROM pg_index ix
+		JOIN pg_class i ON i.oid = ix.indexrelid
+		JOIN pg_class t ON t.oid = ix.indrelid
+		JOIN pg_am am ON am.oid = i.relam
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE n.nspname = current_schema() AND NOT t.relispartition
+		AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = ix.indexrelid)`).
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]*existingIndex, len(rows))
+	for _, row := range rows {
+		indexes[row.Name] = row
+	}
+
+	return indexes, nil
+}
+
+// modelIndexes returns the names of the indexes defined by the gorm tags of
+// the store models, which the migrations create
+func (db *postgresGormImpl) modelIndexes(store IPostgresGormStore) (map[string]bool, error) {
+	names := make(map[string]bool)
+	cache := &sync.Map{}
+
+	for _, model := range store.Models() {
+		s, err := schema.Parse(model, cache, db.db.NamingStrategy)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range s.ParseIndexes() {
+			names[index.Name] = true
+		}
+	}
+
+	return names, nil
+}
+
+// reconcileSchema creates the declared tables and indexes that do not exist
+// and reports every other difference with the declarations. Nothing existing
+// is altered or dropped, changing it is left to a migration.
+func (db *postgresGormImpl) reconcileSchema(store IPostgresGormStore) ([]*SchemaDrift, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), db.timeout)
+	defer cancel()
+
+	drifts := make([]*SchemaDrift, 0)
+
+	tables, err := db.existingTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tables failed: %v", err)
+	}
+
+	declared := make([]IndexSchema, 0)
+	declaredTables := make(map[string]bool)
+
+	for _, table := range store.Tables() {
+		declaredTables[table.Name] = true
+
+		for _, index := range table.Indexes {
+			if index.Table == "" {
+				index.Table = table.Name
+			}
+			declared = append(declared, index)
+		}
+
+		existing, ok := tables[table.Name]
+		if !ok {
+			if table.DDL == "" {
+				drifts = append(drifts, &SchemaDrift{Kind: DriftTableMissing, Table: table.Name})
+				continue
+			}
+
+			if err := db.createTable(ctx, &table); err != nil {
+				return nil, err
+			}
+
+			tables[table.Name] = &existingTable{Name: table.Name, Partitioned: table.Partition != nil}
+			continue
+		}
+
+		if drift := partitionDrift(&table, existing); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	declared = append(declared, store.Indexes()...)
+
+	indexes, err := db.existingIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list indexes failed: %v", err)
+	}
+
+	modelIndexes, err := db.modelIndexes(store)
+	if err != nil {
+		return nil, fmt.Errorf("parse models failed: %v", err)
+	}
+
+	declaredIndexes := make(map[string]bool, len(declared))
+
+	for i := range declared {
+		index := &declared[i]
+		declaredIndexes[index.Name] = true
+		declaredTables[index.Table] = true
+
+		existing, ok := indexes[index.Name]
+		if !ok {
+			table, ok := tables[index.Table]
+			if !ok {
+				drifts = append(drifts, &SchemaDrift{Kind: DriftTableMissing, Table: index.Table, Index: index.Name})
+				continue
+			}
+
+			if err := db.createIndex(index, !table.Partitioned); err != nil {
+				return nil, err
+			}
+
+			drifts = append(drifts, &SchemaDrift{Kind: DriftIndexCreated, Table: index.Table, Index: index.Name})
+			continue
+		}
+
+		if !existing.Valid {
+			drifts = append(drifts, &SchemaDrift{Kind: DriftIndexInvalid, Table: existing.Table, Index: index.Name})
+			continue
+		}
+
+		if diffs := existing.diff(index); len(diffs) > 0 {
+			drifts = append(drifts, &SchemaDrift{
+				Kind:   DriftIndexChanged,
+				Table:  index.Table,
+				Index:  index.Name,
+				Detail: strings.Join(diffs, "; "),
+			})
+		}
+	}
+
+	undeclared := make([]*SchemaDrift, 0)
+	for _, existing := range indexes {
+		if !declaredTables[existing.Table] || declaredIndexes[existing.Name] || modelIndexes[existing.Name] {
+			continue
+		}
+
+		undeclared = append(undeclared, &SchemaDrift{Kind: DriftIndexUndeclared, Table: existing.Table, Index: existing.Name})
+	}
+
+	slices.SortFunc(undeclared, func(a, b *SchemaDrift) int {
+		return strings.Compare(a.Index, b.Index)
+	})
+
+	return append(drifts, undeclared...), nil
+}
+
+func partitionDrift(table *TableSchema, existing *existingTable) *SchemaDrift {
+	switch {
+	case table.Partition == nil && existing.Partitioned:
+		return &SchemaDrift{Kind: DriftPartitionChanged, Table: table.Name,
+			Detail: fmt.Sprintf("partitioned by %s, declared unpartitioned", existing.PartitionKey)}
+	case table.Partition != nil && !existing.Partitioned:
+		return &SchemaDrift{Kind: DriftPartitionChanged, Table: table.Name,
+			Detail: "unpartitioned, declared " + table.Partition.clause()}
+	case table.Partition != nil &&
+		normalizeSQL("PARTITION BY "+existing.PartitionKey) != normalizeSQL(table.Partition.clause()):
+		return &SchemaDrift{Kind: DriftPartitionChanged, Table: table.Name,
+			Detail: fmt.Sprintf("partitioned by %s, declared %s", existing.PartitionKey, table.Partition.clause())}
+	}
+
+	return nil
+}
+
+func (db *postgresGormImpl) createTable(ctx context.Context, table *TableSchema) error {
+	ddl := strings.TrimRight(strings.TrimSpace(table.DDL), ";")
+	if table.Partition != nil {
+		ddl += " " + table.Partition.clause()
+	}
+
+	if err := db.db.WithContext(ctx).Exec(ddl).Error; err != nil {
+		return fmt.Errorf("create table %s failed: %v", table.Name, err)
+	}
+
+	db.log.Infof("table %s created", table.Name)
+	return nil
+}
+
+// createIndex builds an index without a timeout, as it can take long on a
+// large table. A concurrent build does not block the writes to the table.
+func (db *postgresGormImpl) createIndex(index *IndexSchema, concurrently bool) error {
+	db.log.Infof("creating index %s on %s", index.Name, index.Table)
+
+	if err := db.db.Exec(index.DDL(concurrently)).Error; err != nil {
+		return fmt.Errorf("create index %s failed: %v", index.Name, err)
+	}
+
+	return nil
+}
+
+// reconcileSchemas reconciles the schema of every store and logs the drift
+func (db *postgresGormImpl) reconcileSchemas() error {
+	for _, store := range db.stores {
+		drifts, err := db.reconcileSchema(store)
+		if err != nil {
+			return err
+		}
+
+		for _, drift := range drifts {
+			if drift.Kind == DriftIndexCreated {
+				db.log.Infof("schema drift: %s", drift)
+			} else {
+				db.log.Warnf("schema drift: %s", drift)
+			}
+		}
+
+		db.log.Infof("schema reconciled, %d drifts", len(drifts))
+
+		if err := NewPostgresPartitioner(db.db, store.Tables(), db.log).Ensure(time.Now().Unix()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package storedriver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexSchemaDDL(t *testing.T) {
+	for _, c := range []struct {
+		name         string
+		index        IndexSchema
+		concurrently bool
+		want         string
+	}{
+		{
+			"plain",
+			IndexSchema{Name: "idx_rows_address", Table: "rows", Columns: []string{"address", "height DESC"}},
+			true,
+			`CREATE INDEX CONCURRENTLY IF NOT EXISTS "idx_rows_address" ON "rows" USING btree ("address", "height" DESC)`,
+		},
+		{
+			"unique with a method",
+			IndexSchema{Name: "idx_rows_time", Table: "rows", Columns: []string{"time"}, Unique: true, Method: "BRIN"},
+			false,
+			`CREATE UNIQUE INDEX IF NOT EXISTS "idx_rows_time" ON "rows" USING brin ("time")`,
+		},
+		{
+			"expression, include and predicate",
+			IndexSchema{Name: "idx_rows_funded", Table: "rows", Columns: []string{"lower(address) asc"},
+				Include: []string{"balance"}, Where: "balance > 0"},
+			true,
+			`CREATE INDEX CONCURRENTLY IF NOT EXISTS "idx_rows_funded" ON "rows" USING btree ((lower(address)) ASC) INCLUDE ("balance") WHERE balance > 0`,
+		},
+		{
+			"quoted name",
+			IndexSchema{Name: `idx_"rows"`, Table: "rows", Columns: []string{"address"}},
+			false,
+			`CREATE INDEX IF NOT EXISTS "idx_""rows""" ON "rows" USING btree ("address")`,
+		},
+	} {
+		if got := c.index.DDL(c.concurrently); got != c.want {
+			t.Errorf("%s:\n got %s\nwant %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestJoinColumns(t *testing.T) {
+	for _, c := range []struct {
+		columns []string
+		want    string
+	}{
+		{nil, ""},
+		{[]string{"address"}, `"address"`},
+		{[]string{" time_index  desc "}, `"time_index" DESC`},
+		{[]string{"address", "height Asc"}, `"address", "height" ASC`},
+		{[]string{"lower(address)"}, `(lower(address))`},
+		{[]string{"(data->>'type') DESC"}, `((data->>'type')) DESC`},
+		// not a plain lower case identifier, so an expression
+		{[]string{"Address"}, `(Address)`},
+	} {
+		if got := joinColumns(c.columns); got != c.want {
+			t.Errorf("joinColumns(%q) = %s, want %s", c.columns, got, c.want)
+		}
+	}
+}
+
+func TestNormalizeSQL(t *testing.T) {
+	// declared expressions against the text pg_get_indexdef and pg_get_expr
+	// deparse them to
+	for _, c := range []struct {
+		declared string
+		deparsed string
+		equal    bool
+	}{
+		{"address", "address", true},
+		{"lower(address)", "lower((address)::text)", true},
+		{"data->>'type'", "(data ->> 'type'::text)", true},
+		{"balance > 0", "(balance > 0)", true},
+		{"type = 'bond'", "((type)::text = 'bond'::text)", true},
+		{"stake > 0 AND unbond_height IS NULL", "((stake > 0) AND (unbond_height IS NULL))", true},
+		{`"time"`, "\"time\"", true},
+		// a literal deparsed in quotes is reported as a change
+		{"time_index >= 1704067200", "(time_index >= '1704067200'::bigint)", false},
+		{"balance > 0", "(balance > 1)", false},
+		{"address", "lower((address)::text)", false},
+	} {
+		if equal := normalizeSQL(c.declared) == normalizeSQL(c.deparsed); equal != c.equal {
+			t.Errorf("%q and %q: equal = %v, want %v", c.declared, c.deparsed, equal, c.equal)
+		}
+	}
+}
+
+func TestExistingIndexDiff(t *testing.T) {
+	declared := IndexSchema{Name: "idx_rows", Table: "rows", Columns: []string{"address", "height DESC"}}
+
+	for _, c := range []struct {
+		name     string
+		existing existingIndex
+		index    IndexSchema
+		// prefixes of the expected diffs
+		want []string
+	}{
+		{
+			"same, sort order ignored",
+			existingIndex{Table: "rows", Method: "btree", KeyColumns: 2, Columns: "address\nheight"},
+			declared,
+			nil,
+		},
+		{
+			"deparsed expression, include and predicate",
+			existingIndex{Table: "rows", Method: "btree", KeyColumns: 1,
+				Columns: "lower((address)::text)\nbalance", Predicate: "(balance > 0)"},
+			IndexSchema{Table: "rows", Columns: []string{"lower(address)"}, Include: []string{"balance"}, Where: "balance > 0"},
+			nil,
+		},
+		{
+			"table, method and uniqueness",
+			existingIndex{Table: "old_rows", Method: "hash", Unique: true, KeyColumns: 2, Columns: "address\nheight"},
+			declared,
+			[]string{"on table old_rows", "method hash, declared btree", "unique true, declared false"},
+		},
+		{
+			"key column moved to include",
+			existingIndex{Table: "rows", Method: "btree", KeyColumns: 1, Columns: "address\nheight"},
+			declared,
+			[]string{"columns (address), declared (address, height DESC)", "include (height), declared ()"},
+		},
+		{
+			"no columns",
+			existingIndex{Table: "rows", Method: "btree", KeyColumns: 2},
+			declared,
+			[]string{"columns (), declared"},
+		},
+		{
+			"predicate",
+			existingIndex{Table: "rows", Method: "btree", KeyColumns: 2, Columns: "address\nheight", Predicate: "(balance > 0)"},
+			declared,
+			[]string{`predicate "(balance > 0)", declared ""`},
+		},
+	} {
+		diffs := c.existing.diff(&c.index)
+
+		if len(diffs) != len(c.want) {
+			t.Errorf("%s: diffs %q, want %d", c.name, diffs, len(c.want))
+			continue
+		}
+
+		for i, diff := range diffs {
+			if !strings.HasPrefix(diff, c.want[i]) {
+				t.Errorf("%s: diff %d = %q, want %q", c.name, i, diff, c.want[i])
+			}
+		}
+	}
+}
+
+func TestPartitionDrift(t *testing.T) {
+	monthly := &PartitionSchema{Strategy: "range", Columns: []string{"time"}, Monthly: true}
+
+	for _, c := range []struct {
+		name      string
+		partition *PartitionSchema
+		existing  existingTable
+		drift     bool
+	}{
+		{"unpartitioned", nil, existingTable{}, false},
+		{"same key", monthly, existingTable{Partitioned: true, PartitionKey: `RANGE ("time")`}, false},
+		{"partitioned, declared unpartitioned", nil, existingTable{Partitioned: true, PartitionKey: `RANGE ("time")`}, true},
+		{"unpartitioned, declared partitioned", monthly, existingTable{}, true},
+		{"other strategy", monthly, existingTable{Partitioned: true, PartitionKey: `LIST ("time")`}, true},
+		{"other column", monthly, existingTable{Partitioned: true, PartitionKey: "RANGE (time_index)"}, true},
+	} {
+		table := &TableSchema{Name: "rows", Partition: c.partition}
+		c.existing.Name = "rows"
+
+		drift := partitionDrift(table, &c.existing)
+
+		if (drift != nil) != c.drift {
+			t.Errorf("%s: drift %v, want %v", c.name, drift, c.drift)
+			continue
+		}
+
+		if drift != nil && (drift.Kind != DriftPartitionChanged || drift.Table != "rows") {
+			t.Errorf("%s: unexpected drift %s", c.name, drift)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package storedriver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/1pactus/1pactus-react/config"
+	"github.com/1pactus/1pactus-react/log"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqliteGormImpl serves the postgres stores from an embedded sqlite file, for
+// development and integration tests. The tables are created from the models
+// of the stores instead of the versioned migrations, and the tables and
+// indexes postgres needs for scale, such as partitions, are left out. The
+// driver links the sqlite C library, so it needs cgo.
+type sqliteGormImpl struct {
+	conf    *config.SqliteConfig
+	db      *gorm.DB
+	stores  []IPostgresGormStore
+	timeout time.Duration
+	log     log.ILogger
+}
+
+func (db *sqliteGormImpl) Close() {
+	if db.db != nil {
+		db, err := db.db.DB()
+		if err == nil {
+			db.Close()
+		}
+	}
+}
+
+func SqliteGormStart(name string, conf *config.SqliteConfig, stores []IPostgresGormStore) error {
+	m := &sqliteGormImpl{
+		conf:    conf,
+		stores:  stores,
+		timeout: time.Second * 10, // Default timeout
+		log:     log.WithKv("module", "store").WithKv("sqlite", name),
+	}
+
+	if err := m.connect(); err != nil {
+		return fmt.Errorf("gorm sqlite [%s] open failed: %v", name, err)
+	}
+
+	for _, store := range m.stores {
+		store.Init(m)
+	}
+
+	if err := m.createSchema(); err != nil {
+		m.Close()
+		return fmt.Errorf("gorm sqlite [%s] schema create failed: %v", name, err)
+	}
+
+	m.log.Infof("gorm sqlite %s opened and initialized", m.path())
+	return nil
+}
+
+func (db *sqliteGormImpl) path() string {
+	return filepath.Join(db.conf.Dir, db.conf.File)
+}
+
+func (db *sqliteGormImpl) connect() error {
+	if err := os.MkdirAll(db.conf.Dir, 0o755); err != nil {
+		return err
+	}
+
+	// transactions take the write lock when they begin, so a commit never
+	// fails upgrading a read lock held alongside another writer
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=%d&_journal_mode=WAL&_synchronous=NORMAL&_txlock=immediate",
+		db.path(), db.conf.BusyTimeout)
+
+	var gormLogLevel logger.LogLevel
+	switch db.log.GetInternalLogger().GetLevel() {
+	case zerolog.DebugLevel:
+		gormLogLevel = logger.Info
+	case zerolog.ErrorLevel:
+		gormLogLevel = logger.Error
+	default:
+		gormLogLevel = logger.Warn
+	}
+
+	gormDB, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(gormLogLevel),
+		NowFunc: func() time.Time {
+			return time.Now().Local()
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	sqliteDb, err := gormDB.DB()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := sqliteDb.PingContext(ctx); err != nil {
+		sqliteDb.Close()
+		return err
+	}
+
+	db.db = gormDB
+	return nil
+}
+
+// createSchema creates the tables of the models and the declared indexes
+// sqlite supports, then seeds the stores
+func (db *sqliteGormImpl) createSchema() error {
+	for _, store := range db.stores {
+		if err := db.db.AutoMigrate(store.Models()...); err != nil {
+			return err
+		}
+
+		for _, index := range store.Indexes() {
+			if index.Method != "" && index.Method != IndexMethodBtree {
+				db.log.Debugf("index %s skipped, sqlite has no %s index", index.Name, index.Method)
+				continue
+			}
+
+			if err := db.db.Exec(sqliteIndexDDL(&index)).Error; err != nil {
+				return fmt.Errorf("create index %s failed: %v", index.Name, err)
+			}
+		}
+
+		if err := store.Seed(); err != nil {
+			return fmt.Errorf("seed failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// sqliteIndexDDL is IndexSchema.DDL for sqlite, which has neither index
+// methods nor included columns
+func sqliteIndexDDL(index *IndexSchema) string {
+	var sb strings.Builder
+
+	sb.WriteString("CREATE ")
+	if index.Unique {
+		sb.WriteString("UNIQUE ")
+	}
+	fmt.Fprintf(&sb, "INDEX IF NOT EXISTS %s ON %s (%s)",
+		quoteIdent(index.Name), quoteIdent(index.Table), joinColumns(index.Columns))
+
+	if index.Where != "" {
+		fmt.Fprintf(&sb, " WHERE %s", index.Where)
+	}
+
+	return sb.String()
+}
+
+func (db *sqliteGormImpl) GetDB() *gorm.DB {
+	return db.db
+}
+
+// GetReadDB returns the database itself, sqlite has no replicas
+func (db *sqliteGormImpl) GetReadDB() *gorm.DB {
+	return db.db
+}
+
+func (db *sqliteGormImpl) GetTimeout() time.Duration {
+	return db.timeout
+}
+
+func (db *sqliteGormImpl) WithContext(ctx context.Context) *gorm.DB {
+	return db.db.WithContext(ctx)
+}